@@ -0,0 +1,23 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// NewCore creates a zap core which writes messages at or above level to
+// sink. This allows the sink to be combined with other cores using
+// zapcore.NewTee. Loggers using the core should be created with
+// zap.AddCaller so messages have a location.
+func NewCore(sink *OctantSink, level zapcore.Level) zapcore.Core {
+	config := zap.NewDevelopmentEncoderConfig()
+	// The sink's message format doesn't have a field for the logger name.
+	config.NameKey = ""
+
+	return zapcore.NewCore(zapcore.NewConsoleEncoder(config), sink, level)
+}
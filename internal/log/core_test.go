@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNewCore(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	core := zapcore.NewTee(NewCore(s, zapcore.InfoLevel), zapcore.NewNopCore())
+	logger := zap.New(core, zap.AddCaller()).Named("test")
+
+	logger.Debug("dropped")
+	logger.Info("hello", zap.String("key", "value"))
+
+	m := <-ch
+	require.Equal(t, "INFO", m.LogLevel)
+	require.Equal(t, "hello", m.Text)
+	require.Contains(t, m.Location, "core_test.go")
+	require.JSONEq(t, `{"key": "value"}`, m.JSON)
+	require.Len(t, ch, 0)
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// flusher is a writer which buffers output.
+type flusher interface {
+	Flush() error
+}
+
+// NewJSONLinesWriter listens to sink and writes each message to w as a line
+// of JSON. Heartbeats are not written. If w can be flushed, it is flushed
+// after each message. Calling the returned stop function cancels the listener
+// and waits for pending messages to be written.
+func NewJSONLinesWriter(w io.Writer, sink *OctantSink) (stop func()) {
+	ch, cancel := sink.Listen()
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		encoder := json.NewEncoder(w)
+		f, canFlush := w.(flusher)
+
+		for m := range ch {
+			if m.IsHeartbeat() {
+				continue
+			}
+
+			if err := encoder.Encode(m); err != nil {
+				continue
+			}
+
+			if canFlush {
+				_ = f.Flush()
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
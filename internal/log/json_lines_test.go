@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJSONLinesWriter(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	stop := NewJSONLinesWriter(w, s)
+
+	_, err := s.Write(logLine("INFO", "file.go:50", "first"))
+	require.NoError(t, err)
+	_, err = s.Write(logLine("ERROR", "file.go:51", "second"))
+	require.NoError(t, err)
+
+	stop()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var got []Message
+	for _, line := range lines {
+		var m Message
+		require.NoError(t, json.Unmarshal([]byte(line), &m))
+		got = append(got, m)
+	}
+
+	require.Equal(t, "first", got[0].Text)
+	require.Equal(t, "INFO", got[0].LogLevel)
+	require.Equal(t, "second", got[1].Text)
+	require.Equal(t, "ERROR", got[1].LogLevel)
+}
+
+func TestNewJSONLinesWriter_sinkClosed(t *testing.T) {
+	s := NewOctantSink()
+
+	var buf bytes.Buffer
+	stop := NewJSONLinesWriter(&buf, s)
+
+	require.NoError(t, s.Close())
+	stop()
+}
+
+func TestNewJSONLinesWriter_heartbeat(t *testing.T) {
+	s := NewOctantSink(WithHeartbeat(5 * time.Millisecond))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	var buf bytes.Buffer
+	stop := NewJSONLinesWriter(&buf, s)
+
+	_, err := s.Write(logLine("INFO", "file.go:50", "first"))
+	require.NoError(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+	stop()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1)
+}
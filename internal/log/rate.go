@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"sync"
+	"time"
+)
+
+// UnknownLevel is the level messages with a level which can't be parsed are
+// counted under by LogRate.
+const UnknownLevel = "unknown"
+
+// LogRate counts the messages sent by sink by level. Heartbeats are not
+// counted. The counts for each interval are sent on the returned channel,
+// which is closed when the returned stop func is called or the sink is
+// closed.
+func LogRate(sink *OctantSink, interval time.Duration) (<-chan map[string]int, func()) {
+	ch, cancel := sink.Listen()
+
+	out := make(chan map[string]int, 1)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		counts := map[string]int{}
+
+		for {
+			select {
+			case <-done:
+				return
+			case m, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				if m.IsHeartbeat() {
+					continue
+				}
+
+				key := UnknownLevel
+				if level, err := m.ParsedLevel(); err == nil {
+					key = level.String()
+				}
+				counts[key]++
+			case <-ticker.C:
+				select {
+				case out <- counts:
+				case <-done:
+					return
+				}
+				counts = map[string]int{}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return out, func() {
+		once.Do(func() {
+			close(done)
+			cancel()
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogRate(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, stop := LogRate(s, 100*time.Millisecond)
+	defer stop()
+
+	for _, level := range []string{"INFO", "info", "ERROR", "bogus"} {
+		_, err := s.Write(logLine(level, "file.go:50", "message"))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, map[string]int{"info": 2, "error": 1, UnknownLevel: 1}, <-ch)
+
+	_, err := s.Write(logLine("WARN", "file.go:50", "message"))
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]int{"warn": 1}, <-ch)
+
+	stop()
+	stop()
+
+	for range ch {
+	}
+}
+
+func TestLogRate_heartbeat(t *testing.T) {
+	s := NewOctantSink(WithHeartbeat(10 * time.Millisecond))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, stop := LogRate(s, 100*time.Millisecond)
+	defer stop()
+
+	_, err := s.Write(logLine("INFO", "file.go:50", "message"))
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]int{"info": 1}, <-ch)
+}
+
+func TestMessage_ParsedLevel(t *testing.T) {
+	level, err := Message{LogLevel: "WARN"}.ParsedLevel()
+	require.NoError(t, err)
+	require.Equal(t, zapcore.WarnLevel, level)
+
+	_, err = Message{LogLevel: "bogus"}.ParsedLevel()
+	require.Error(t, err)
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"strconv"
+	"sync"
+)
+
+// replayEntry is a message kept for replay and its size.
+type replayEntry struct {
+	message Message
+	size    int
+}
+
+// replayBuffer holds recent messages for replaying to new listeners. The
+// buffer is bounded by the serialized size of the messages it holds. Entries
+// are kept in a ring which grows as needed.
+type replayBuffer struct {
+	maxBytes int
+
+	entries []replayEntry
+	head    int
+	count   int
+	bytes   int
+
+	mu sync.Mutex
+}
+
+func newReplayBuffer(maxBytes int) *replayBuffer {
+	return &replayBuffer{maxBytes: maxBytes}
+}
+
+// messageOverhead is the size of a message serialized as JSON without its
+// values.
+const messageOverhead = len(`{"Date":,"LogLevel":"","Location":"","Text":"","JSON":"","Stack":"","Logger":"","Kind":""}`)
+
+// replaySize returns the size of m serialized as JSON, not counting escaping.
+// It avoids serializing messages while sending them.
+func replaySize(m Message) int {
+	return messageOverhead + len(strconv.FormatInt(m.Date, 10)) + len(m.LogLevel) +
+		len(m.Location) + len(m.Text) + len(m.JSON) + len(m.Stack) + len(m.Logger) + len(m.Kind)
+}
+
+// add adds m to the buffer and evicts the oldest messages until the buffer is
+// within its limit. A message larger than the limit is not kept and doesn't
+// evict other messages.
+func (r *replayBuffer) add(m Message) {
+	size := replaySize(m)
+
+	if size > r.maxBytes {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for r.count > 0 && r.bytes+size > r.maxBytes {
+		r.bytes -= r.entries[r.head].size
+		r.entries[r.head] = replayEntry{}
+		r.head = (r.head + 1) % len(r.entries)
+		r.count--
+	}
+
+	if r.count == len(r.entries) {
+		r.grow()
+	}
+
+	r.entries[(r.head+r.count)%len(r.entries)] = replayEntry{message: m, size: size}
+	r.count++
+	r.bytes += size
+}
+
+// grow doubles the capacity of the ring.
+func (r *replayBuffer) grow() {
+	n := 2 * len(r.entries)
+	if n == 0 {
+		n = 16
+	}
+
+	entries := make([]replayEntry, n)
+	for i := 0; i < r.count; i++ {
+		entries[i] = r.entries[(r.head+i)%len(r.entries)]
+	}
+
+	r.entries = entries
+	r.head = 0
+}
+
+// list returns the buffered messages from oldest to newest.
+func (r *replayBuffer) list() []Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	messages := make([]Message, 0, r.count)
+	for i := 0; i < r.count; i++ {
+		messages = append(messages, r.entries[(r.head+i)%len(r.entries)].message)
+	}
+
+	return messages
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOctantSink_WithReplayBytes(t *testing.T) {
+	small := logLine("INFO", "file.go:50", "small")
+	smallMessage, err := ConvertBytesToMessage(small)
+	require.NoError(t, err)
+	smallSize := replaySize(smallMessage)
+
+	large := logLine("INFO", "file.go:50", strings.Repeat("x", 10*smallSize))
+	largeMessage, err := ConvertBytesToMessage(large)
+	require.NoError(t, err)
+	largeSize := replaySize(largeMessage)
+
+	s := NewOctantSink(WithReplayBytes(largeSize + 2*smallSize))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	for _, line := range [][]byte{small, large, small, small} {
+		_, err := s.Write(line)
+		require.NoError(t, err)
+	}
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	// The first small message is evicted to keep the large message and the
+	// two most recent small messages within the limit.
+	requireMessages(t, ch, []Message{largeMessage, smallMessage, smallMessage})
+
+	_, err = s.Write(large)
+	require.NoError(t, err)
+	require.Equal(t, largeMessage, <-ch)
+
+	ch2, cancel2 := s.Listen()
+	defer cancel2()
+
+	// Writing another large message evicts the older large message.
+	requireMessages(t, ch2, []Message{smallMessage, smallMessage, largeMessage})
+}
+
+func TestOctantSink_WithReplayBytes_oversized(t *testing.T) {
+	s := NewOctantSink(WithReplayBytes(10))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	_, err := s.Write(logLine("INFO", "file.go:50", "too large to keep"))
+	require.NoError(t, err)
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	require.Len(t, ch, 0)
+}
+
+func TestReplaySize(t *testing.T) {
+	m := Message{
+		Date:     1600000000,
+		LogLevel: "info",
+		Location: "file.go:50",
+		Text:     "message",
+		JSON:     "payload",
+		Stack:    "stack",
+		Logger:   "sink",
+	}
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, len(data), replaySize(m))
+}
+
+func TestReplayBuffer_oversized(t *testing.T) {
+	size := replaySize(Message{Text: "0"})
+	r := newReplayBuffer(3 * size)
+
+	for i := 0; i < 3; i++ {
+		r.add(Message{Text: strconv.Itoa(i)})
+	}
+
+	r.add(Message{Text: strings.Repeat("x", 4*size)})
+
+	var got []string
+	for _, m := range r.list() {
+		got = append(got, m.Text)
+	}
+	require.Equal(t, []string{"0", "1", "2"}, got)
+	require.Equal(t, 3*size, r.bytes)
+}
+
+func TestReplayBuffer_wrap(t *testing.T) {
+	size := replaySize(Message{Text: "0"})
+	r := newReplayBuffer(3 * size)
+
+	for i := 0; i < 40; i++ {
+		r.add(Message{Text: strconv.Itoa(i % 10)})
+	}
+
+	var got []string
+	for _, m := range r.list() {
+		got = append(got, m.Text)
+	}
+	require.Equal(t, []string{"7", "8", "9"}, got)
+	require.Equal(t, 3*size, r.bytes)
+}
+
+func requireMessages(t *testing.T, ch <-chan Message, expected []Message) {
+	require.Len(t, ch, len(expected))
+	for _, m := range expected {
+		require.Equal(t, m, <-ch)
+	}
+}
+
+func TestOctantSink_ListenReplayContext(t *testing.T) {
+	s := NewOctantSink(WithReplayBytes(1 << 20))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	const total = 3000
+	attach := make(chan struct{})
+
+	go func() {
+		for i := 0; i < total; i++ {
+			if i == total/3 {
+				close(attach)
+			}
+			_, err := s.Write(logLine("INFO", "file.go:50", strconv.Itoa(i)))
+			if err != nil {
+				panic(err)
+			}
+		}
+	}()
+
+	<-attach
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, _ := s.ListenReplayContext(ctx)
+
+	var got []int
+	for m := range ch {
+		i, err := strconv.Atoi(m.Text)
+		require.NoError(t, err)
+		got = append(got, i)
+
+		if i == total-1 {
+			cancel()
+		}
+	}
+
+	require.NotEmpty(t, got)
+	for j := 1; j < len(got); j++ {
+		require.Equal(t, got[j-1]+1, got[j], "messages are not contiguous at %d", j)
+	}
+	require.Equal(t, total-1, got[len(got)-1])
+}
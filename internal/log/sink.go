@@ -6,16 +6,26 @@
 package log
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/gobwas/glob"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
+// DefaultTimeLayout is the layout used to parse message timestamps.
+const DefaultTimeLayout = "2006-01-02T15:04:05.000Z0700"
+
 // Message is an Octant log message.
 type Message struct {
 	// Date is the seconds since epoch.
@@ -28,6 +38,68 @@ type Message struct {
 	Text string
 	// JSON is the JSON payload.
 	JSON string
+	// Stack is the stack trace, if the message has one.
+	Stack string
+	// Logger is the name of the sink which produced the message.
+	Logger string
+	// Kind is set for messages generated by the sink rather than logged. It
+	// is empty for log messages.
+	Kind string
+}
+
+const (
+	// MessageKindHeartbeat is the kind of heartbeat messages.
+	MessageKindHeartbeat = "heartbeat"
+	// MessageKindGap is the kind of messages sent in place of messages
+	// dropped by a coalescing listener.
+	MessageKindGap = "gap"
+)
+
+// DroppedMessage is returned by a middleware to drop a message.
+var DroppedMessage = Message{}
+
+// ParsedLevel returns the message's log level.
+func (m Message) ParsedLevel() (zapcore.Level, error) {
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(m.LogLevel)); err != nil {
+		return l, fmt.Errorf("parse log level: %w", err)
+	}
+
+	return l, nil
+}
+
+// IsHeartbeat returns true if the message is a heartbeat sent by the sink.
+func (m Message) IsHeartbeat() bool {
+	return m.Kind == MessageKindHeartbeat
+}
+
+// IsGap returns true if the message was sent in place of dropped messages.
+func (m Message) IsGap() bool {
+	return m.Kind == MessageKindGap
+}
+
+// IsFatal returns true if the message's level is dpanic, panic, or fatal. The
+// process may exit or panic after writing such a message.
+func (m Message) IsFatal() bool {
+	l, err := m.ParsedLevel()
+	return err == nil && l >= zapcore.DPanicLevel
+}
+
+// ParsedLocation splits the message's location into a file and line number.
+// If the location doesn't end with a line number, file is the location and ok
+// is false.
+func (m Message) ParsedLocation() (file string, line int, ok bool) {
+	i := strings.LastIndex(m.Location, ":")
+	if i == -1 {
+		return m.Location, 0, false
+	}
+
+	line, err := strconv.Atoi(m.Location[i+1:])
+	if err != nil || line < 1 {
+		return m.Location, 0, false
+	}
+
+	return m.Location[:i], line, true
 }
 
 // ListenCancelFunc is a function for canceling a sink listener.
@@ -39,99 +111,1044 @@ type OctantSinkOption func(o *OctantSink)
 // OctantSink is an Octant log sink for zap. It creates a method that
 // allows multiple loggers to listen to message.
 type OctantSink struct {
-	listeners map[string]chan Message
-	converter func(b []byte) (Message, error)
+	// Counters are accessed atomically and are first to ensure 64-bit alignment.
+	writes       int64
+	sends        int64
+	blockedNanos int64
+	// sampleCounts are the number of messages seen for each level below
+	// error, starting at debug.
+	sampleCounts [zapcore.ErrorLevel - zapcore.DebugLevel]int64
+	sampling     int64
+
+	listeners map[string]*listener
+	converter func(ctx context.Context, b []byte) (Message, error)
+
+	locationPrefix string
+	callerTrim     []string
+
+	timeLayout          string
+	receiveTimeFallback bool
+	coalesceOnFull      bool
+
+	replay *replayBuffer
+
+	minLevel    zapcore.Level
+	hasMinLevel bool
+
+	dedupWindow time.Duration
+	duplicate   *duplicateMessage
+	dedupMu     sync.Mutex
+
+	continuationWait time.Duration
+	pending          *pendingMessage
+	dropContinuation bool
+	continuationMu   sync.Mutex
+
+	fatalHook  func(m Message)
+	middleware []func(m Message) Message
+
+	onFirstListener func()
+	onLastListener  func()
+	// transitionMu serializes adding and removing listeners with the
+	// callbacks for the transitions they cause.
+	transitionMu sync.Mutex
+
+	loggerName string
+
+	heartbeatInterval time.Duration
+	done              chan struct{}
+	closeOnce         sync.Once
 
 	mu sync.RWMutex
 }
 
+// listener is a sink listener. If filter is set, only messages it returns
+// true for are sent to the listener.
+type listener struct {
+	ch     chan Message
+	filter func(m Message) bool
+
+	// mu serializes coalescing sends to the listener.
+	mu sync.Mutex
+}
+
+// gapText is the text of the message sent in place of dropped messages.
+const gapText = "messages dropped"
+
+// duplicateMessage tracks a message which is being deduplicated.
+type duplicateMessage struct {
+	message Message
+	count   int
+	timer   *time.Timer
+}
+
+// pendingMessage is a message waiting for continuation lines.
+type pendingMessage struct {
+	message Message
+	timer   *time.Timer
+}
+
 var _ zap.Sink = &OctantSink{}
 
+// SinkMetrics are metrics for an OctantSink.
+type SinkMetrics struct {
+	// Writes is the total number of writes to the sink.
+	Writes int64
+	// Sends is the total number of messages sent to listeners.
+	Sends int64
+	// Blocked is the total time spent waiting on listeners with full buffers.
+	Blocked time.Duration
+}
+
 // NewOctantSink creates an instance of OctantSink.
 func NewOctantSink(options ...OctantSinkOption) *OctantSink {
 	o := &OctantSink{
-		listeners: map[string]chan Message{},
-		converter: ConvertBytesToMessage,
+		listeners:  map[string]*listener{},
+		timeLayout: DefaultTimeLayout,
+		done:       make(chan struct{}),
+	}
+	o.converter = func(_ context.Context, b []byte) (Message, error) {
+		return o.convert(b)
 	}
 
 	for _, option := range options {
 		option(o)
 	}
 
+	if o.heartbeatInterval > 0 {
+		go o.heartbeat()
+	}
+
 	return o
 }
 
+// WithLocationPrefix prefixes the location of every message with prefix. This
+// is useful for identifying which instance produced a message when multiple
+// replicas are logging.
+func WithLocationPrefix(prefix string) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.locationPrefix = prefix
+	}
+}
+
+// WithCallerTrim removes the first of prefixes which the location of a message
+// starts with, e.g. the module path, to make locations readable. Locations are
+// trimmed before WithLocationPrefix is applied regardless of option order.
+func WithCallerTrim(prefixes ...string) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.callerTrim = prefixes
+	}
+}
+
+// WithTimeLayout sets the layout used to parse message timestamps. This
+// should match the time encoder of the zap logger writing to the sink.
+func WithTimeLayout(layout string) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.timeLayout = layout
+	}
+}
+
+// WithContextConverter replaces the converter which converts zap messages to
+// Messages with one which receives the context the message was written with.
+// Options which modify converted messages, e.g. WithLocationPrefix, still
+// apply to the converted messages.
+func WithContextConverter(converter func(ctx context.Context, b []byte) (Message, error)) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.converter = converter
+	}
+}
+
+// WithReceiveTimeFallback uses the time a message was received as its date if
+// its timestamp can't be parsed. Without this option, the message is dropped.
+func WithReceiveTimeFallback() OctantSinkOption {
+	return func(o *OctantSink) {
+		o.receiveTimeFallback = true
+	}
+}
+
+// WithCoalesceOnFull prevents a listener with a full buffer from blocking the
+// sink. The oldest buffered messages are dropped and replaced with a gap
+// message whose JSON payload contains the number of dropped messages.
+func WithCoalesceOnFull() OctantSinkOption {
+	return func(o *OctantSink) {
+		o.coalesceOnFull = true
+	}
+}
+
+// WithReplayBytes keeps recent messages and sends them to new listeners
+// before any live messages. The oldest messages are evicted once the total
+// size of the kept messages, serialized as JSON, exceeds maxBytes.
+func WithReplayBytes(maxBytes int) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.replay = newReplayBuffer(maxBytes)
+	}
+}
+
+// WithMinLevel drops messages below level before they are converted. Messages
+// whose level can't be parsed are not dropped. An invalid level is ignored.
+func WithMinLevel(level string) OctantSinkOption {
+	return func(o *OctantSink) {
+		var l zapcore.Level
+		if err := l.UnmarshalText([]byte(level)); err != nil {
+			return
+		}
+
+		o.minLevel = l
+		o.hasMinLevel = true
+	}
+}
+
+// belowMinLevel returns true if the level of the zap message in p is below
+// the sink's minimum level.
+func (o *OctantSink) belowMinLevel(p []byte) bool {
+	if !o.hasMinLevel {
+		return false
+	}
+
+	l, ok := parseLevel(p)
+	return ok && l < o.minLevel
+}
+
+// WithSampling delivers only the first and every nth message after it for
+// each level. Messages at error level or above and messages whose level can't
+// be parsed are always delivered. Sampled out messages are not converted.
+func WithSampling(n int) OctantSinkOption {
+	return func(o *OctantSink) {
+		if n > 1 {
+			o.sampling = int64(n)
+		}
+	}
+}
+
+// sampledOut returns true if the zap message in p should be dropped by
+// sampling.
+func (o *OctantSink) sampledOut(p []byte) bool {
+	if o.sampling == 0 {
+		return false
+	}
+
+	l, ok := parseLevel(p)
+	if !ok || l >= zapcore.ErrorLevel || l < zapcore.DebugLevel {
+		return false
+	}
+
+	count := atomic.AddInt64(&o.sampleCounts[l-zapcore.DebugLevel], 1)
+	return (count-1)%o.sampling != 0
+}
+
+// parseLevel parses the level of the zap message in p without converting the
+// whole message.
+func parseLevel(p []byte) (zapcore.Level, bool) {
+	i := bytes.IndexByte(p, '\t')
+	if i == -1 {
+		return 0, false
+	}
+	rest := p[i+1:]
+
+	j := bytes.IndexByte(rest, '\t')
+	if j == -1 {
+		return 0, false
+	}
+
+	var l zapcore.Level
+	if err := l.UnmarshalText(rest[:j]); err != nil {
+		return 0, false
+	}
+
+	return l, true
+}
+
+// WithDedup collapses consecutive identical messages (same level and text)
+// written within window. The first message is delivered immediately. When the
+// window elapses, a summary of the first message with a count of occurrences
+// in its JSON payload is delivered if duplicates were seen.
+func WithDedup(window time.Duration) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.dedupWindow = window
+	}
+}
+
+// WithContinuationLines attaches writes which begin with neither a timestamp
+// nor a level, e.g. the lines of a stack trace written separately from its
+// message, to the stack of the previous message. Each message is held until
+// the next message is written, wait elapses, or the sink is synced or closed.
+func WithContinuationLines(wait time.Duration) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.continuationWait = wait
+	}
+}
+
+// WithMiddleware adds fn to the functions which process messages before they
+// are delivered, e.g. to redact secrets. Middleware runs in the order it was
+// added, after continuation lines are attached. If fn returns DroppedMessage,
+// the message is dropped and later middleware isn't run.
+func WithMiddleware(fn func(m Message) Message) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.middleware = append(o.middleware, fn)
+	}
+}
+
+// WithFatalHook calls fn with every dpanic, panic, or fatal message before
+// Write returns. fn runs synchronously on the writing goroutine, so it can
+// flush or notify before the process exits.
+func WithFatalHook(fn func(m Message)) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.fatalHook = fn
+	}
+}
+
+// WithOnFirstListener calls fn when the sink goes from having no listeners to
+// having one, e.g. to start an expensive log source. fn is called on the
+// goroutine adding the listener after the sink's lock is released. Callbacks
+// are called in the order of the transitions, so fn must not add or cancel
+// listeners.
+func WithOnFirstListener(fn func()) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.onFirstListener = fn
+	}
+}
+
+// WithOnLastListener calls fn when the sink's last listener is canceled or
+// the sink is closed with listeners, e.g. to stop an expensive log source. fn
+// is called on the goroutine removing the listener after the sink's lock is
+// released. Like WithOnFirstListener, fn must not add or cancel listeners.
+func WithOnLastListener(fn func()) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.onLastListener = fn
+	}
+}
+
+// WithLoggerName sets the logger of every message the sink produces to name.
+// This identifies the subsystem which produced a message when multiple sinks
+// feed one aggregator.
+func WithLoggerName(name string) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.loggerName = name
+	}
+}
+
+// WithHeartbeat sends a heartbeat message to all listeners every interval.
+// This allows listeners to detect a dead connection during quiet periods.
+func WithHeartbeat(interval time.Duration) OctantSinkOption {
+	return func(o *OctantSink) {
+		o.heartbeatInterval = interval
+	}
+}
+
+// heartbeat sends heartbeat messages until the sink is closed. Heartbeats
+// are not sent if the sink's minimum level is above debug.
+func (o *OctantSink) heartbeat() {
+	ticker := time.NewTicker(o.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.done:
+			return
+		case t := <-ticker.C:
+			if o.hasMinLevel && zapcore.DebugLevel < o.minLevel {
+				continue
+			}
+
+			o.sendHeartbeat(Message{
+				Date:     t.Unix(),
+				LogLevel: "debug",
+				Text:     "heartbeat",
+				Logger:   o.loggerName,
+				Kind:     MessageKindHeartbeat,
+			})
+		}
+	}
+}
+
+// sendHeartbeat sends m to listeners with room for it. A listener with a full
+// buffer is receiving messages, so it doesn't need a heartbeat, and skipping
+// it keeps the sink's lock from being held while waiting on the listener.
+func (o *OctantSink) sendHeartbeat(m Message) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	for _, l := range o.listeners {
+		if l.filter != nil && !l.filter(m) {
+			continue
+		}
+
+		select {
+		case l.ch <- m:
+			atomic.AddInt64(&o.sends, 1)
+		default:
+		}
+	}
+}
+
 // Write converts the message to a Message and sends it to all listeners.
 // The message format is IS8061 date[\t]level[\t]location[\t]text[\t]optional payload[\n]
 func (o *OctantSink) Write(p []byte) (n int, err error) {
-	m, err := o.converter(p)
+	return o.WriteContext(context.Background(), p)
+}
+
+// WriteContext is like Write, but passes ctx to the sink's converter.
+func (o *OctantSink) WriteContext(ctx context.Context, p []byte) (n int, err error) {
+	atomic.AddInt64(&o.writes, 1)
+
+	if o.continuationWait > 0 && o.appendContinuation(p) {
+		return len(p), nil
+	}
+
+	if o.belowMinLevel(p) || o.sampledOut(p) {
+		if o.continuationWait > 0 {
+			o.hold(nil)
+		}
+		return len(p), nil
+	}
+
+	m, err := o.converter(ctx, p)
 	if err != nil {
 		return 0, fmt.Errorf("convert bytes to message: %w", err)
 	}
+	m.Location = o.location(m.Location)
+	if o.loggerName != "" {
+		m.Logger = o.loggerName
+	}
 
-	o.send(m)
+	if o.fatalHook != nil && m.IsFatal() {
+		defer o.fatalHook(m)
+	}
+
+	if o.continuationWait > 0 {
+		o.hold(&m)
+		return len(p), nil
+	}
+
+	o.deliver(m)
 
 	return len(p), nil
 }
 
+// location trims callers from and prefixes a message location.
+func (o *OctantSink) location(location string) string {
+	for _, prefix := range o.callerTrim {
+		if prefix != "" && strings.HasPrefix(location, prefix) {
+			location = strings.TrimPrefix(location, prefix)
+			break
+		}
+	}
+
+	if o.locationPrefix != "" {
+		location = o.locationPrefix + "/" + location
+	}
+
+	return location
+}
+
+// deliver runs middleware on m, deduplicates it, and sends it.
+func (o *OctantSink) deliver(m Message) {
+	for _, fn := range o.middleware {
+		m = fn(m)
+		if m == DroppedMessage {
+			return
+		}
+	}
+
+	if o.dedupWindow > 0 && o.isDuplicate(m) {
+		return
+	}
+
+	o.send(m)
+}
+
+// appendContinuation appends p to the stack of the pending message if p is a
+// continuation. It returns false if p isn't a continuation or there is no
+// message to continue.
+func (o *OctantSink) appendContinuation(p []byte) bool {
+	if !o.isContinuation(p) {
+		return false
+	}
+
+	o.continuationMu.Lock()
+	defer o.continuationMu.Unlock()
+
+	if o.dropContinuation {
+		return true
+	}
+
+	if o.pending == nil {
+		return false
+	}
+
+	m := &o.pending.message
+	m.Stack = joinLines(m.Stack, strings.TrimRight(string(p), "\r\n"))
+	return true
+}
+
+// isContinuation returns true if p begins with neither a timestamp nor a
+// level.
+func (o *OctantSink) isContinuation(p []byte) bool {
+	if _, ok := parseLevel(p); ok {
+		return false
+	}
+
+	i := bytes.IndexByte(p, '\t')
+	if i == -1 {
+		return true
+	}
+
+	_, err := time.Parse(o.timeLayout, string(p[:i]))
+	return err != nil
+}
+
+// hold delivers the pending message and makes m pending. If m is nil, the
+// message was dropped and its continuation lines are dropped as well.
+func (o *OctantSink) hold(m *Message) {
+	o.continuationMu.Lock()
+	defer o.continuationMu.Unlock()
+
+	o.flushPending()
+
+	o.dropContinuation = m == nil
+	if m == nil {
+		return
+	}
+
+	pm := &pendingMessage{message: *m}
+	pm.timer = time.AfterFunc(o.continuationWait, func() {
+		o.continuationMu.Lock()
+		defer o.continuationMu.Unlock()
+
+		if o.pending == pm {
+			o.flushPending()
+		}
+	})
+	o.pending = pm
+}
+
+// flushPending delivers the pending message. continuationMu must be held.
+func (o *OctantSink) flushPending() {
+	pm := o.pending
+	if pm == nil {
+		return
+	}
+
+	o.pending = nil
+	pm.timer.Stop()
+	o.deliver(pm.message)
+}
+
+// joinLines joins two blocks of lines. Either block may be empty.
+func joinLines(a, b string) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+
+	return a + "\n" + b
+}
+
+// isDuplicate returns true if m is a duplicate of the message currently being
+// deduplicated. If it isn't, the previous message's summary is flushed and m
+// is tracked.
+func (o *OctantSink) isDuplicate(m Message) bool {
+	o.dedupMu.Lock()
+	defer o.dedupMu.Unlock()
+
+	if d := o.duplicate; d != nil {
+		if d.message.LogLevel == m.LogLevel && d.message.Text == m.Text {
+			d.count++
+			return true
+		}
+
+		d.timer.Stop()
+		o.flushDuplicate()
+	}
+
+	d := &duplicateMessage{message: m, count: 1}
+	d.timer = time.AfterFunc(o.dedupWindow, func() {
+		o.dedupMu.Lock()
+		defer o.dedupMu.Unlock()
+
+		if o.duplicate == d {
+			o.flushDuplicate()
+		}
+	})
+	o.duplicate = d
+
+	return false
+}
+
+// flushDuplicate sends a summary for the message being deduplicated if
+// duplicates were seen. dedupMu must be held.
+func (o *OctantSink) flushDuplicate() {
+	d := o.duplicate
+	o.duplicate = nil
+
+	if d.count < 2 {
+		return
+	}
+
+	o.send(withCount(d.message, d.count))
+}
+
+// withCount adds a count to a message's JSON payload.
+func withCount(m Message, count int) Message {
+	payload := map[string]interface{}{}
+	if m.JSON != "" {
+		if err := json.Unmarshal([]byte(m.JSON), &payload); err != nil {
+			payload = map[string]interface{}{"payload": m.JSON}
+		}
+	}
+	payload["count"] = count
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return m
+	}
+
+	m.JSON = string(data)
+	return m
+}
+
+// send records m for replay and sends it to all listeners.
 func (o *OctantSink) send(m Message) {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
 
-	for _, ch := range o.listeners {
-		ch <- m
+	if o.replay != nil {
+		o.replay.add(m)
+	}
+
+	o.broadcast(m)
+}
+
+// broadcast sends m to all listeners. mu must be held.
+func (o *OctantSink) broadcast(m Message) {
+	for _, l := range o.listeners {
+		if l.filter != nil && !l.filter(m) {
+			continue
+		}
+
+		if o.coalesceOnFull {
+			coalesce(l, m)
+			atomic.AddInt64(&o.sends, 1)
+			continue
+		}
+
+		select {
+		case l.ch <- m:
+		default:
+			start := time.Now()
+			l.ch <- m
+			atomic.AddInt64(&o.blockedNanos, int64(time.Since(start)))
+		}
+
+		atomic.AddInt64(&o.sends, 1)
+	}
+}
+
+// coalesce sends m to the listener. If the listener's buffer is full, the two
+// oldest messages are dropped to make room for a gap message and m. The gap
+// message is only sent if a message was dropped.
+func coalesce(l *listener, m Message) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	select {
+	case l.ch <- m:
+		return
+	default:
+	}
+
+	dropped := 0
+	for i := 0; i < 2; i++ {
+		select {
+		case old := <-l.ch:
+			dropped += gapCount(old)
+		default:
+		}
+	}
+
+	// The buffer was drained after it was found to be full, so there is
+	// nothing to report.
+	if dropped == 0 {
+		l.ch <- m
+		return
 	}
+
+	gap := withCount(Message{
+		Date:     time.Now().Unix(),
+		LogLevel: "warn",
+		Text:     gapText,
+		Logger:   m.Logger,
+		Kind:     MessageKindGap,
+	}, dropped)
+
+	l.ch <- gap
+	l.ch <- m
 }
 
-// Sync is a no-op as.
+// gapCount returns the number of messages m represents. A gap message
+// represents the messages it replaced.
+func gapCount(m Message) int {
+	if !m.IsGap() {
+		return 1
+	}
+
+	var payload struct {
+		Count int `json:"count"`
+	}
+	if err := json.Unmarshal([]byte(m.JSON), &payload); err != nil || payload.Count == 0 {
+		return 1
+	}
+
+	return payload.Count
+}
+
+// Metrics returns metrics for the sink.
+func (o *OctantSink) Metrics() SinkMetrics {
+	return SinkMetrics{
+		Writes:  atomic.LoadInt64(&o.writes),
+		Sends:   atomic.LoadInt64(&o.sends),
+		Blocked: time.Duration(atomic.LoadInt64(&o.blockedNanos)),
+	}
+}
+
+// Sync delivers the message waiting for continuation lines, if any.
 func (o *OctantSink) Sync() error {
+	o.continuationMu.Lock()
+	o.flushPending()
+	o.continuationMu.Unlock()
+
 	return nil
 }
 
 // Close closes the sink and its listeners.
 func (o *OctantSink) Close() error {
+	o.closeOnce.Do(func() {
+		close(o.done)
+	})
+
+	o.continuationMu.Lock()
+	o.flushPending()
+	o.continuationMu.Unlock()
+
+	o.dedupMu.Lock()
+	if o.duplicate != nil {
+		o.duplicate.timer.Stop()
+		o.flushDuplicate()
+	}
+	o.dedupMu.Unlock()
+
+	o.transitionMu.Lock()
+	if o.closeListeners() && o.onLastListener != nil {
+		o.onLastListener()
+	}
+	o.transitionMu.Unlock()
+
+	return nil
+}
+
+// closeListeners closes and removes all listeners. It returns true if there
+// were any listeners.
+func (o *OctantSink) closeListeners() bool {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 
-	for k, ch := range o.listeners {
-		close(ch)
+	hadListeners := len(o.listeners) > 0
+
+	for k, l := range o.listeners {
+		close(l.ch)
 		delete(o.listeners, k)
 	}
 
-	return nil
+	return hadListeners
+}
+
+// ListenerCount returns the number of listeners.
+func (o *OctantSink) ListenerCount() int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return len(o.listeners)
 }
 
 // Listen creates a channel for listening for messages and cancel func.
 func (o *OctantSink) Listen() (<-chan Message, ListenCancelFunc) {
+	return o.listen(nil)
+}
+
+// ListenReplayContext creates a channel for listening for messages which is
+// canceled when ctx is done. If the sink keeps messages for replay, they are
+// sent before live messages. The replayed messages are read and the listener
+// is registered while holding the sink's lock, so no message is missed or
+// sent twice.
+func (o *OctantSink) ListenReplayContext(ctx context.Context) (<-chan Message, ListenCancelFunc) {
+	ch, cancel := o.listen(nil)
+
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-stop:
+		}
+	}()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			close(stop)
+			cancel()
+		})
+	}
+}
+
+// ListenWithFilter creates a channel for listening for messages which fn
+// returns true for. fn is called for every message while the sink's read lock
+// is held, so it must be cheap and must not block.
+func (o *OctantSink) ListenWithFilter(fn func(m Message) bool) (<-chan Message, ListenCancelFunc) {
+	return o.listen(fn)
+}
+
+// ListenJSONOnly creates a channel for listening for messages with a valid
+// JSON payload. Messages without a payload are skipped.
+func (o *OctantSink) ListenJSONOnly() (<-chan Message, ListenCancelFunc) {
+	return o.listen(func(m Message) bool {
+		return m.JSON != "" && json.Valid([]byte(m.JSON))
+	})
+}
+
+// ListenBatched creates a channel for listening for batches of messages. A
+// batch is sent when it has maxBatch messages or maxWait after its first
+// message was received, whichever comes first. If maxWait is not positive,
+// batches are only sent when they are full. A partial batch is sent when the
+// listener is canceled or the sink is closed.
+func (o *OctantSink) ListenBatched(maxBatch int, maxWait time.Duration) (<-chan []Message, ListenCancelFunc) {
+	if maxBatch < 1 {
+		maxBatch = 1
+	}
+
+	ch, cancel := o.listen(nil)
+
+	out := make(chan []Message, 1)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(out)
+
+		var batch []Message
+		var timer *time.Timer
+		var timeout <-chan time.Time
+
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+
+			if len(batch) == 0 {
+				return true
+			}
+
+			// Prefer sending the batch if there is room, even if the
+			// listener was canceled.
+			select {
+			case out <- batch:
+				batch = nil
+				return true
+			default:
+			}
+
+			select {
+			case out <- batch:
+				batch = nil
+				return true
+			case <-done:
+				return false
+			}
+		}
+
+		// drain reads messages until the listener is canceled so senders
+		// blocked on a full listener don't keep cancel from removing it.
+		drain := func() {
+			for m := range ch {
+				batch = append(batch, m)
+			}
+			flush()
+		}
+
+		for {
+			select {
+			case m, ok := <-ch:
+				if !ok {
+					flush()
+					return
+				}
+
+				batch = append(batch, m)
+				if len(batch) >= maxBatch {
+					if !flush() {
+						drain()
+						return
+					}
+				} else if timer == nil && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				if !flush() {
+					drain()
+					return
+				}
+			case <-done:
+				drain()
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return out, func() {
+		once.Do(func() {
+			close(done)
+			cancel()
+		})
+	}
+}
+
+// ListenExcludingLocations creates a channel for listening for messages
+// whose location doesn't match any of the patterns. A pattern matches if it
+// is a substring of the location or it is a glob matching the location.
+func (o *OctantSink) ListenExcludingLocations(patterns ...string) (<-chan Message, ListenCancelFunc) {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		if g, err := glob.Compile(pattern); err == nil {
+			globs = append(globs, g)
+		}
+	}
+
+	return o.listen(func(m Message) bool {
+		for _, pattern := range patterns {
+			if strings.Contains(m.Location, pattern) {
+				return false
+			}
+		}
+
+		for _, g := range globs {
+			if g.Match(m.Location) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// listen creates a listener with an optional filter.
+func (o *OctantSink) listen(filter func(m Message) bool) (<-chan Message, ListenCancelFunc) {
+	o.transitionMu.Lock()
+	id, ch, first := o.addListener(filter)
+	if first && o.onFirstListener != nil {
+		o.onFirstListener()
+	}
+	o.transitionMu.Unlock()
+
+	return ch, func() {
+		o.transitionMu.Lock()
+		defer o.transitionMu.Unlock()
+
+		if o.removeListener(id) && o.onLastListener != nil {
+			o.onLastListener()
+		}
+	}
+}
+
+// addListener registers a listener and sends it the messages kept for replay.
+// first is true if it is the only listener.
+func (o *OctantSink) addListener(filter func(m Message) bool) (id string, ch chan Message, first bool) {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 
-	id := rand.String(6)
-	ch := make(chan Message, 1000)
-	o.listeners[id] = ch
+	id = rand.String(6)
+	ch = make(chan Message, 1000)
+	o.listeners[id] = &listener{ch: ch, filter: filter}
 
-	return ch, func() {
-		o.mu.Lock()
-		defer o.mu.Unlock()
+	if o.replay != nil {
+		replay := o.replay.list()
+		if len(replay) > cap(ch) {
+			replay = replay[len(replay)-cap(ch):]
+		}
+
+		for _, m := range replay {
+			if filter == nil || filter(m) {
+				ch <- m
+			}
+		}
+	}
+
+	return id, ch, len(o.listeners) == 1
+}
 
-		close(ch)
+// removeListener closes and removes the listener with id. It returns true if
+// it was the last listener.
+func (o *OctantSink) removeListener(id string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
 
-		delete(o.listeners, id)
+	// The listener will have been removed if the sink was closed.
+	l, ok := o.listeners[id]
+	if !ok {
+		return false
 	}
+
+	close(l.ch)
+
+	delete(o.listeners, id)
+
+	return len(o.listeners) == 0
+}
+
+// convert converts a zap message using the sink's configuration.
+func (o *OctantSink) convert(b []byte) (Message, error) {
+	var now func() time.Time
+	if o.receiveTimeFallback {
+		now = time.Now
+	}
+
+	return convertBytesToMessage(b, o.timeLayout, now)
 }
 
 // ConvertBytesToMessage converts a zap message string to a Message instance.
 func ConvertBytesToMessage(b []byte) (Message, error) {
-	parts := strings.Split(strings.TrimSpace(string(b)), "\t")
+	return convertBytesToMessage(b, DefaultTimeLayout, nil)
+}
+
+// convertBytesToMessage converts a zap message string to a Message instance.
+// Lines after the first are the message's stack. Timestamps are parsed using
+// layout. If now is not nil, it is used to date messages with invalid
+// timestamps.
+func convertBytesToMessage(b []byte, layout string, now func() time.Time) (Message, error) {
+	text := strings.TrimSpace(string(b))
+
+	var stack string
+	if i := strings.IndexByte(text, '\n'); i != -1 {
+		text, stack = strings.TrimSpace(text[:i]), strings.TrimRight(text[i+1:], "\r\n")
+	}
+
+	parts := strings.Split(text, "\t")
 	pLen := len(parts)
 
 	if pLen < 4 || pLen > 5 {
 		return Message{}, errors.New("unknown log message format")
 	}
 
-	t, err := time.Parse("2006-01-02T15:04:05.000Z0700", parts[0])
+	t, err := time.Parse(layout, parts[0])
 	if err != nil {
-		return Message{}, fmt.Errorf("invalid log timestamp: %w", err)
+		if now == nil {
+			return Message{}, fmt.Errorf("invalid log timestamp: %w", err)
+		}
+		t = now()
 	}
 
 	m := Message{
@@ -139,6 +1156,7 @@ func ConvertBytesToMessage(b []byte) (Message, error) {
 		LogLevel: parts[1],
 		Location: parts[2],
 		Text:     parts[3],
+		Stack:    stack,
 	}
 
 	if pLen > 4 {
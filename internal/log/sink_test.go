@@ -6,9 +6,15 @@
 package log
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -16,11 +22,11 @@ import (
 )
 
 func TestOctantSink(t *testing.T) {
-	validConvert := func(b []byte) (Message, error) {
+	validConvert := func(ctx context.Context, b []byte) (Message, error) {
 		return Message{}, nil
 	}
 
-	invalidConvert := func(b []byte) (Message, error) {
+	invalidConvert := func(ctx context.Context, b []byte) (Message, error) {
 		return Message{}, errors.New("invalid")
 	}
 
@@ -176,3 +182,930 @@ func TestConvertBytesToMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestMessage_ParsedLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		file     string
+		line     int
+		ok       bool
+	}{
+		{location: "log/sink.go:50", file: "log/sink.go", line: 50, ok: true},
+		{location: `C:\src\main.go:7`, file: `C:\src\main.go`, line: 7, ok: true},
+		{location: "log/sink.go", file: "log/sink.go"},
+		{location: "log/sink.go:", file: "log/sink.go:"},
+		{location: "log/sink.go:abc", file: "log/sink.go:abc"},
+		{location: "log/sink.go:-1", file: "log/sink.go:-1"},
+		{location: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			file, line, ok := Message{Location: tt.location}.ParsedLocation()
+			require.Equal(t, tt.file, file)
+			require.Equal(t, tt.line, line)
+			require.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestOctantSink_WithLocationPrefix(t *testing.T) {
+	s := NewOctantSink(WithLocationPrefix("pod-abc"))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	locations := []string{"file.go:50", "other.go:12", "third.go:1"}
+	for _, location := range locations {
+		_, err := s.Write(logLine("INFO", location, "message"))
+		require.NoError(t, err)
+	}
+
+	for _, location := range locations {
+		m := <-ch
+		require.Equal(t, "pod-abc/"+location, m.Location)
+	}
+}
+
+func TestOctantSink_WithCallerTrim(t *testing.T) {
+	s := NewOctantSink(WithCallerTrim("github.com/vmware-tanzu/octant/", "internal/"))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	locations := map[string]string{
+		"github.com/vmware-tanzu/octant/internal/api/api.go:50": "internal/api/api.go:50",
+		"internal/log/sink.go:12":                               "log/sink.go:12",
+		"k8s.io/client-go/rest/request.go:1":                    "k8s.io/client-go/rest/request.go:1",
+	}
+
+	for location, expected := range locations {
+		_, err := s.Write(logLine("INFO", location, "message"))
+		require.NoError(t, err)
+		require.Equal(t, expected, (<-ch).Location)
+	}
+}
+
+func TestOctantSink_WithCallerTrim_locationPrefix(t *testing.T) {
+	trim := WithCallerTrim("github.com/vmware-tanzu/octant/")
+	prefix := WithLocationPrefix("pod-abc")
+
+	tests := map[string][]OctantSinkOption{
+		"trim first":   {trim, prefix},
+		"prefix first": {prefix, trim},
+	}
+
+	for name, options := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := NewOctantSink(options...)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			ch, cancel := s.Listen()
+			defer cancel()
+
+			_, err := s.Write(logLine("INFO", "github.com/vmware-tanzu/octant/internal/api/api.go:50", "message"))
+			require.NoError(t, err)
+			require.Equal(t, "pod-abc/internal/api/api.go:50", (<-ch).Location)
+		})
+	}
+}
+
+func TestOctantSink_WithDedup(t *testing.T) {
+	s := NewOctantSink(WithDedup(50 * time.Millisecond))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for i := 0; i < 5; i++ {
+		_, err := s.Write(logLine("INFO", "file.go:50", "reconciling"))
+		require.NoError(t, err)
+	}
+
+	first := <-ch
+	require.Equal(t, "reconciling", first.Text)
+	require.Empty(t, first.JSON)
+
+	summary := <-ch
+	require.Equal(t, "reconciling", summary.Text)
+	require.JSONEq(t, `{"count": 5}`, summary.JSON)
+
+	select {
+	case m := <-ch:
+		t.Fatalf("unexpected message: %v", m)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestOctantSink_WithDedup_close(t *testing.T) {
+	s := NewOctantSink(WithDedup(time.Hour))
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		_, err := s.Write(logLine("INFO", "file.go:50", "reconciling"))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, s.Close())
+
+	var got []Message
+	for m := range ch {
+		got = append(got, m)
+	}
+
+	require.Len(t, got, 2)
+	require.Empty(t, got[0].JSON)
+	require.Equal(t, "reconciling", got[1].Text)
+	require.JSONEq(t, `{"count": 3}`, got[1].JSON)
+}
+
+func TestOctantSink_WithCoalesceOnFull(t *testing.T) {
+	s := NewOctantSink(WithCoalesceOnFull())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	total := cap(ch) + 1
+	for i := 1; i <= total; i++ {
+		_, err := s.Write(logLine("INFO", "file.go:50", strconv.Itoa(i)))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, ch, cap(ch))
+
+	var got []Message
+	for len(ch) > 0 {
+		got = append(got, <-ch)
+	}
+
+	require.Equal(t, "3", got[0].Text)
+
+	gap := got[len(got)-2]
+	require.Equal(t, gapText, gap.Text)
+	require.True(t, gap.IsGap())
+	require.JSONEq(t, `{"count": 2}`, gap.JSON)
+
+	require.Equal(t, strconv.Itoa(total), got[len(got)-1].Text)
+}
+
+func TestCoalesce_nothingDropped(t *testing.T) {
+	// An unbuffered listener is always full, but has nothing to drop.
+	l := &listener{ch: make(chan Message)}
+	m := Message{Text: "message"}
+
+	go coalesce(l, m)
+
+	require.Equal(t, m, <-l.ch)
+
+	select {
+	case extra := <-l.ch:
+		t.Fatalf("unexpected message %v", extra)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestGapCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        Message
+		expected int
+	}{
+		{
+			name:     "message",
+			m:        Message{Text: "message"},
+			expected: 1,
+		},
+		{
+			name:     "gap",
+			m:        Message{Text: gapText, JSON: `{"count":3}`, Kind: MessageKindGap},
+			expected: 3,
+		},
+		{
+			name:     "logged with gap text",
+			m:        Message{Text: gapText, JSON: `{"count":3}`},
+			expected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, gapCount(test.m))
+		})
+	}
+}
+
+func logLine(level, location, text string) []byte {
+	return []byte(strings.Join([]string{
+		"2020-09-03T14:39:51.115-0400",
+		level,
+		location,
+		text,
+	}, "\t") + "\n")
+}
+
+func TestOctantSink_WithHeartbeat(t *testing.T) {
+	interval := 20 * time.Millisecond
+	s := NewOctantSink(WithHeartbeat(interval))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		select {
+		case m := <-ch:
+			require.Equal(t, "debug", m.LogLevel)
+			require.Equal(t, "heartbeat", m.Text)
+			require.True(t, m.IsHeartbeat())
+		case <-time.After(10 * interval):
+			t.Fatal("timed out waiting for heartbeat")
+		}
+	}
+
+	require.True(t, time.Since(start) >= 3*interval)
+}
+
+func TestOctantSink_WithHeartbeat_minLevel(t *testing.T) {
+	interval := 10 * time.Millisecond
+	s := NewOctantSink(WithHeartbeat(interval), WithMinLevel("info"))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	time.Sleep(5 * interval)
+	require.Len(t, ch, 0)
+}
+
+func TestOctantSink_WithHeartbeat_fullListener(t *testing.T) {
+	interval := 5 * time.Millisecond
+	s := NewOctantSink(WithHeartbeat(interval))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for i := 0; i < cap(ch); i++ {
+		_, err := s.Write(logLine("INFO", "file.go:50", strconv.Itoa(i)))
+		require.NoError(t, err)
+	}
+
+	// Heartbeats to the full listener are skipped rather than blocking, so
+	// the listener can still be canceled.
+	time.Sleep(5 * interval)
+
+	canceled := make(chan struct{})
+	go func() {
+		cancel()
+		close(canceled)
+	}()
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("cancel did not return")
+	}
+
+	for m := range ch {
+		require.False(t, m.IsHeartbeat())
+	}
+}
+
+func TestOctantSink_Metrics(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	_, cancel1 := s.Listen()
+	defer cancel1()
+	_, cancel2 := s.Listen()
+	defer cancel2()
+
+	for i := 0; i < 3; i++ {
+		_, err := s.Write(logLine("INFO", "file.go:50", "message"))
+		require.NoError(t, err)
+	}
+
+	_, err := s.Write([]byte("invalid"))
+	require.Error(t, err)
+
+	got := s.Metrics()
+	require.Equal(t, int64(4), got.Writes)
+	require.Equal(t, int64(6), got.Sends)
+	require.Equal(t, time.Duration(0), got.Blocked)
+}
+
+func TestOctantSink_Metrics_blocked(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for i := 0; i < cap(ch); i++ {
+		_, err := s.Write(logLine("INFO", "file.go:50", "message"))
+		require.NoError(t, err)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		<-ch
+	}()
+
+	_, err := s.Write(logLine("INFO", "file.go:50", "message"))
+	require.NoError(t, err)
+
+	require.True(t, s.Metrics().Blocked > 0)
+}
+
+func TestOctantSink_ListenExcludingLocations(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.ListenExcludingLocations("client-go/transport", "*/klog/*")
+	defer cancel()
+
+	locations := []string{
+		"k8s.io/client-go/transport/round_trippers.go:70",
+		"internal/api/api.go:12",
+		"vendor/klog/klog.go:10",
+		"internal/log/sink.go:1",
+	}
+	for _, location := range locations {
+		_, err := s.Write(logLine("INFO", location, "message"))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, "internal/api/api.go:12", (<-ch).Location)
+	require.Equal(t, "internal/log/sink.go:1", (<-ch).Location)
+	require.Len(t, ch, 0)
+}
+
+type traceIDKey struct{}
+
+func TestOctantSink_WithContextConverter(t *testing.T) {
+	converter := func(ctx context.Context, b []byte) (Message, error) {
+		m, err := ConvertBytesToMessage(b)
+		if err != nil {
+			return Message{}, err
+		}
+
+		if traceID, ok := ctx.Value(traceIDKey{}).(string); ok {
+			m.JSON = `{"traceID":"` + traceID + `"}`
+		}
+
+		return m, nil
+	}
+
+	tests := map[string][]OctantSinkOption{
+		"converter first": {WithContextConverter(converter), WithLocationPrefix("pod-abc")},
+		"prefix first":    {WithLocationPrefix("pod-abc"), WithContextConverter(converter)},
+	}
+
+	for name, options := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := NewOctantSink(options...)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			ch, cancel := s.Listen()
+			defer cancel()
+
+			ctx := context.WithValue(context.Background(), traceIDKey{}, "abc123")
+			_, err := s.WriteContext(ctx, logLine("INFO", "file.go:50", "message"))
+			require.NoError(t, err)
+
+			m := <-ch
+			require.Equal(t, "pod-abc/file.go:50", m.Location)
+			require.JSONEq(t, `{"traceID":"abc123"}`, m.JSON)
+
+			_, err = s.Write(logLine("INFO", "file.go:50", "message"))
+			require.NoError(t, err)
+
+			m = <-ch
+			require.Empty(t, m.JSON)
+		})
+	}
+}
+
+func TestOctantSink_ListenWithFilter(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.ListenWithFilter(func(m Message) bool {
+		return strings.Contains(m.Text, "timeout")
+	})
+	defer cancel()
+
+	for _, text := range []string{"connected", "request timeout", "disconnected", "timeout exceeded"} {
+		_, err := s.Write(logLine("INFO", "file.go:50", text))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, "request timeout", (<-ch).Text)
+	require.Equal(t, "timeout exceeded", (<-ch).Text)
+	require.Len(t, ch, 0)
+}
+
+func TestOctantSink_ListenJSONOnly(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.ListenJSONOnly()
+	defer cancel()
+
+	withPayload := func(text, payload string) []byte {
+		return []byte(strings.TrimSuffix(string(logLine("INFO", "file.go:50", text)), "\n") + "\t" + payload + "\n")
+	}
+
+	lines := [][]byte{
+		logLine("INFO", "file.go:50", "plain"),
+		withPayload("structured", `{"pod":"nginx"}`),
+		withPayload("invalid", `{pod`),
+		logLine("INFO", "file.go:50", "plain again"),
+	}
+
+	for _, line := range lines {
+		_, err := s.Write(line)
+		require.NoError(t, err)
+	}
+
+	got := <-ch
+	require.Equal(t, "structured", got.Text)
+	require.Equal(t, `{"pod":"nginx"}`, got.JSON)
+	require.Len(t, ch, 0)
+}
+
+func TestOctantSink_ListenBatched(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.ListenBatched(3, 100*time.Millisecond)
+	defer cancel()
+
+	write := func(texts ...string) {
+		for _, text := range texts {
+			_, err := s.Write(logLine("INFO", "file.go:50", text))
+			require.NoError(t, err)
+		}
+	}
+
+	texts := func(batch []Message) []string {
+		var got []string
+		for _, m := range batch {
+			got = append(got, m.Text)
+		}
+		return got
+	}
+
+	start := time.Now()
+	write("1", "2", "3", "4")
+
+	// The first batch is full, so it is sent without waiting.
+	require.Equal(t, []string{"1", "2", "3"}, texts(<-ch))
+	require.True(t, time.Since(start) < 100*time.Millisecond)
+
+	// The second batch is sent when the timer fires.
+	require.Equal(t, []string{"4"}, texts(<-ch))
+	require.True(t, time.Since(start) >= 100*time.Millisecond)
+
+	write("5")
+	cancel()
+
+	require.Equal(t, []string{"5"}, texts(<-ch))
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestOctantSink_ListenBatched_cancelWithoutReader(t *testing.T) {
+	s := NewOctantSink()
+	defer func() {
+		_ = s.Close()
+	}()
+
+	_, cancel := s.ListenBatched(1, 0)
+
+	// Write enough messages to fill the batch output and the listener so
+	// the writer blocks.
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		for i := 0; i < 2000; i++ {
+			_, _ = s.Write(logLine("INFO", "file.go:50", strconv.Itoa(i)))
+		}
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	canceled := make(chan struct{})
+	go func() {
+		cancel()
+		close(canceled)
+	}()
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("cancel did not return")
+	}
+
+	select {
+	case <-written:
+	case <-time.After(time.Second):
+		t.Fatal("writer did not finish")
+	}
+}
+
+func TestOctantSink_WithSampling(t *testing.T) {
+	s := NewOctantSink(WithSampling(5))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for i := 0; i < 10; i++ {
+		_, err := s.Write(logLine("DEBUG", "file.go:50", strconv.Itoa(i)))
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := s.Write(logLine("ERROR", "file.go:50", "failed"))
+		require.NoError(t, err)
+	}
+
+	var debug, errs []string
+	for len(ch) > 0 {
+		m := <-ch
+		if m.LogLevel == "DEBUG" {
+			debug = append(debug, m.Text)
+		} else {
+			errs = append(errs, m.Text)
+		}
+	}
+
+	require.Equal(t, []string{"0", "5"}, debug)
+	require.Len(t, errs, 3)
+}
+
+func TestOctantSink_WithReceiveTimeFallback(t *testing.T) {
+	line := []byte(strings.Join([]string{
+		"not-a-timestamp",
+		"INFO",
+		"file.go:50",
+		"message",
+	}, "\t") + "\n")
+
+	s := NewOctantSink()
+	_, err := s.Write(line)
+	require.Error(t, err)
+	require.NoError(t, s.Close())
+
+	s = NewOctantSink(WithReceiveTimeFallback())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	before := time.Now().Unix()
+	_, err = s.Write(line)
+	require.NoError(t, err)
+
+	m := <-ch
+	require.Equal(t, "message", m.Text)
+	require.True(t, m.Date >= before && m.Date <= time.Now().Unix())
+}
+
+func TestOctantSink_WithTimeLayout(t *testing.T) {
+	ts := time.Date(2020, 9, 3, 14, 39, 51, 115123456, time.UTC)
+	line := []byte(strings.Join([]string{
+		ts.Format(time.RFC3339Nano),
+		"INFO",
+		"file.go:50",
+		"message",
+	}, "\t") + "\n")
+
+	s := NewOctantSink()
+	_, err := s.Write(line)
+	require.Error(t, err)
+	require.NoError(t, s.Close())
+
+	s = NewOctantSink(WithTimeLayout(time.RFC3339Nano))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	_, err = s.Write(line)
+	require.NoError(t, err)
+
+	m := <-ch
+	require.Equal(t, "message", m.Text)
+	require.Equal(t, ts.Unix(), m.Date)
+}
+
+func TestOctantSink_WithMinLevel(t *testing.T) {
+	s := NewOctantSink(WithMinLevel("info"))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for _, level := range []string{"DEBUG", "INFO", "debug", "unknown", "ERROR"} {
+		_, err := s.Write(logLine(level, "file.go:50", level))
+		require.NoError(t, err)
+	}
+
+	var got []string
+	for len(ch) > 0 {
+		got = append(got, (<-ch).LogLevel)
+	}
+	require.Equal(t, []string{"INFO", "unknown", "ERROR"}, got)
+}
+
+func BenchmarkOctantSink_Write(b *testing.B) {
+	line := logLine("DEBUG", "file.go:50", "message")
+
+	benchmarks := []struct {
+		name    string
+		options []OctantSinkOption
+	}{
+		{name: "no minimum level"},
+		{name: "info minimum level", options: []OctantSinkOption{WithMinLevel("info")}},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			s := NewOctantSink(bm.options...)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := s.Write(line); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertBytesToMessage_stack(t *testing.T) {
+	line := string(logLine("ERROR", "file.go:50", "failed")) +
+		"main.run\n\t/src/main.go:20\nmain.main\n\t/src/main.go:10\n"
+
+	got, err := ConvertBytesToMessage([]byte(line))
+	require.NoError(t, err)
+
+	require.Equal(t, "failed", got.Text)
+	require.Equal(t, "main.run\n\t/src/main.go:20\nmain.main\n\t/src/main.go:10", got.Stack)
+}
+
+func TestOctantSink_WithContinuationLines(t *testing.T) {
+	s := NewOctantSink(WithContinuationLines(time.Minute), WithMinLevel("info"))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	writes := [][]byte{
+		logLine("ERROR", "file.go:50", "failed"),
+		[]byte("main.run\n"),
+		[]byte("\t/src/main.go:20\n"),
+		[]byte("main.main\n\t/src/main.go:10\n"),
+		logLine("DEBUG", "file.go:60", "dropped"),
+		[]byte("main.debug\n"),
+		logLine("unknown", "file.go:70", "next"),
+	}
+
+	for _, p := range writes {
+		_, err := s.Write(p)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, ch, 1)
+	got := <-ch
+	require.Equal(t, "failed", got.Text)
+	require.Equal(t, "main.run\n\t/src/main.go:20\nmain.main\n\t/src/main.go:10", got.Stack)
+
+	require.NoError(t, s.Sync())
+	require.Len(t, ch, 1)
+	got = <-ch
+	require.Equal(t, "next", got.Text)
+	require.Empty(t, got.Stack)
+}
+
+func TestOctantSink_WithFatalHook(t *testing.T) {
+	var got []Message
+	s := NewOctantSink(WithFatalHook(func(m Message) {
+		got = append(got, m)
+	}))
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for _, level := range []string{"INFO", "ERROR", "DPANIC", "FATAL"} {
+		_, err := s.Write(logLine(level, "file.go:50", "shutting down"))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, got, 2)
+	require.Equal(t, "DPANIC", got[0].LogLevel)
+	require.Equal(t, "FATAL", got[1].LogLevel)
+	require.True(t, got[1].IsFatal())
+
+	// The fatal message is sent to listeners before the hook runs.
+	require.Len(t, ch, 4)
+}
+
+func TestOctantSink_ListenerCount(t *testing.T) {
+	var transitions []string
+	s := NewOctantSink(
+		WithOnFirstListener(func() {
+			transitions = append(transitions, "first")
+		}),
+		WithOnLastListener(func() {
+			transitions = append(transitions, "last")
+		}),
+	)
+
+	require.Equal(t, 0, s.ListenerCount())
+
+	_, cancel1 := s.Listen()
+	_, cancel2 := s.Listen()
+	require.Equal(t, 2, s.ListenerCount())
+	require.Equal(t, []string{"first"}, transitions)
+
+	cancel1()
+	require.Equal(t, 1, s.ListenerCount())
+	require.Equal(t, []string{"first"}, transitions)
+
+	cancel2()
+	cancel2()
+	require.Equal(t, 0, s.ListenerCount())
+	require.Equal(t, []string{"first", "last"}, transitions)
+
+	_, cancel3 := s.Listen()
+	require.Equal(t, []string{"first", "last", "first"}, transitions)
+
+	require.NoError(t, s.Close())
+	cancel3()
+	require.Equal(t, 0, s.ListenerCount())
+	require.Equal(t, []string{"first", "last", "first", "last"}, transitions)
+}
+
+func TestOctantSink_ListenerCount_concurrent(t *testing.T) {
+	var mu sync.Mutex
+	running, invalid := false, false
+	transition := func(start bool) func() {
+		return func() {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if running == start {
+				invalid = true
+			}
+			running = start
+		}
+	}
+
+	s := NewOctantSink(WithOnFirstListener(transition(true)), WithOnLastListener(transition(false)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				_, cancel := s.Listen()
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 0, s.ListenerCount())
+	require.False(t, invalid, "first and last listener callbacks were out of order")
+	require.False(t, running)
+}
+
+func TestOctantSink_WithMiddleware(t *testing.T) {
+	var order []string
+	s := NewOctantSink(
+		WithMiddleware(func(m Message) Message {
+			order = append(order, "redact")
+			m.Text = regexp.MustCompile(`password=\S+`).ReplaceAllString(m.Text, "password=****")
+			return m
+		}),
+		WithMiddleware(func(m Message) Message {
+			order = append(order, "drop")
+			if strings.HasPrefix(m.Text, "health check") {
+				return DroppedMessage
+			}
+			return m
+		}),
+	)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	for _, text := range []string{"connect password=hunter2 host=db", "health check ok"} {
+		_, err := s.Write(logLine("INFO", "file.go:50", text))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, ch, 1)
+	require.Equal(t, "connect password=**** host=db", (<-ch).Text)
+	require.Equal(t, []string{"redact", "drop", "redact", "drop"}, order)
+}
+
+func TestOctantSink_WithLoggerName(t *testing.T) {
+	var middleware, fatal []string
+	s := NewOctantSink(
+		WithLoggerName("plugin-manager"),
+		WithMiddleware(func(m Message) Message {
+			middleware = append(middleware, m.Logger)
+			return m
+		}),
+		WithFatalHook(func(m Message) {
+			fatal = append(fatal, m.Logger)
+		}),
+	)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	ch, cancel := s.Listen()
+	defer cancel()
+
+	_, err := s.Write(logLine("INFO", "file.go:50", "started"))
+	require.NoError(t, err)
+	_, err = s.Write(logLine("FATAL", "file.go:51", "failed"))
+	require.NoError(t, err)
+
+	require.Len(t, ch, 2)
+	m := <-ch
+	require.Equal(t, "plugin-manager", m.Logger)
+	require.Equal(t, []string{"plugin-manager", "plugin-manager"}, middleware)
+	require.Equal(t, []string{"plugin-manager"}, fatal)
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"Logger":"plugin-manager"`)
+
+	var got Message
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, m, got)
+}
@@ -40,6 +40,34 @@ func MetadataHandler(object runtime.Object, linkGenerator link.Interface) (*comp
 	return layout.ToComponent("Metadata"), nil
 }
 
+// ObjectSummary creates a summary of an object's name, namespace, labels,
+// annotations, and age.
+func ObjectSummary(object metav1.Object) component.Component {
+	sections := component.SummarySections{}
+
+	if object == nil {
+		return component.NewSummary("Metadata", sections...)
+	}
+
+	sections.Add("Name", component.NewText(object.GetName()))
+
+	if namespace := object.GetNamespace(); namespace != "" {
+		sections.Add("Namespace", component.NewText(namespace))
+	}
+
+	if labels := object.GetLabels(); len(labels) > 0 {
+		sections.Add("Labels", component.NewLabels(labels))
+	}
+
+	if annotations := object.GetAnnotations(); len(annotations) > 0 {
+		sections.Add("Annotations", component.NewAnnotations(annotations))
+	}
+
+	sections.Add("Age", component.NewTimestamp(object.GetCreationTimestamp().Time))
+
+	return component.NewSummary("Metadata", sections...)
+}
+
 // Metadata represents object metadata.
 type Metadata struct {
 	object runtime.Object
@@ -7,10 +7,12 @@ package printer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -50,3 +52,49 @@ func Test_Metadata(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+func Test_ObjectSummary(t *testing.T) {
+	created := metav1.NewTime(time.Unix(1600000000, 0))
+
+	tests := []struct {
+		name     string
+		object   metav1.Object
+		expected component.Component
+	}{
+		{
+			name: "namespaced object",
+			object: &metav1.ObjectMeta{
+				Name:              "nginx",
+				Namespace:         "default",
+				Labels:            map[string]string{"app": "nginx"},
+				Annotations:       map[string]string{"owner": "team"},
+				CreationTimestamp: created,
+			},
+			expected: component.NewSummary("Metadata", component.SummarySections{
+				{Header: "Name", Content: component.NewText("nginx")},
+				{Header: "Namespace", Content: component.NewText("default")},
+				{Header: "Labels", Content: component.NewLabels(map[string]string{"app": "nginx"})},
+				{Header: "Annotations", Content: component.NewAnnotations(map[string]string{"owner": "team"})},
+				{Header: "Age", Content: component.NewTimestamp(created.Time)},
+			}...),
+		},
+		{
+			name: "cluster scoped object",
+			object: &metav1.ObjectMeta{
+				Name:              "node-1",
+				CreationTimestamp: created,
+			},
+			expected: component.NewSummary("Metadata", component.SummarySections{
+				{Header: "Name", Content: component.NewText("node-1")},
+				{Header: "Age", Content: component.NewTimestamp(created.Time)},
+			}...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ObjectSummary(tt.object)
+			component.AssertEqual(t, tt.expected, got)
+		})
+	}
+}
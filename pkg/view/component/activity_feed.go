@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// ActivityEntry is an entry in an activity feed.
+type ActivityEntry struct {
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ActivityFeedConfig is the contents of ActivityFeed.
+type ActivityFeedConfig struct {
+	Entries []ActivityEntry `json:"entries"`
+}
+
+// ActivityFeed is a component which renders recent activity, newest first.
+//
+// +octant:component
+type ActivityFeed struct {
+	Base
+	Config ActivityFeedConfig `json:"config"`
+}
+
+var _ Component = (*ActivityFeed)(nil)
+
+// NewActivityFeed creates an activity feed component.
+func NewActivityFeed() *ActivityFeed {
+	return &ActivityFeed{
+		Base: newBase(TypeActivityFeed, nil),
+		Config: ActivityFeedConfig{
+			Entries: []ActivityEntry{},
+		},
+	}
+}
+
+// Add adds an entry to the feed.
+func (af *ActivityFeed) Add(entry ActivityEntry) {
+	af.Config.Entries = append(af.Config.Entries, entry)
+}
+
+// IsEmpty returns true if the feed has no entries.
+func (af *ActivityFeed) IsEmpty() bool {
+	return len(af.Config.Entries) == 0
+}
+
+type activityFeedMarshal ActivityFeed
+
+// MarshalJSON implements json.Marshaler. Entries are sorted newest first.
+func (af *ActivityFeed) MarshalJSON() ([]byte, error) {
+	m := activityFeedMarshal(*af)
+	m.Metadata.Type = TypeActivityFeed
+
+	entries := append([]ActivityEntry{}, af.Config.Entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.After(entries[j].Timestamp)
+	})
+	m.Config.Entries = entries
+
+	return json.Marshal(&m)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityFeed_Marshal(t *testing.T) {
+	now := time.Date(2020, 9, 3, 14, 0, 0, 0, time.UTC)
+
+	feed := NewActivityFeed()
+	feed.Add(ActivityEntry{Actor: "alice", Action: "scaled", Target: "deployment/nginx", Timestamp: now.Add(time.Minute)})
+	feed.Add(ActivityEntry{Actor: "bob", Action: "created", Target: "deployment/nginx", Timestamp: now})
+	feed.Add(ActivityEntry{Actor: "carol", Action: "deleted", Target: "pod/nginx-1", Timestamp: now.Add(2 * time.Minute)})
+
+	data, err := json.Marshal(feed)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	var actors []string
+	for _, entry := range got.(*ActivityFeed).Config.Entries {
+		actors = append(actors, entry.Actor)
+	}
+	assert.Equal(t, []string{"carol", "alice", "bob"}, actors)
+
+	AssertEqual(t, feed, got)
+}
+
+func TestActivityFeed_IsEmpty(t *testing.T) {
+	feed := NewActivityFeed()
+	assert.True(t, feed.IsEmpty())
+
+	feed.Add(ActivityEntry{Actor: "alice", Timestamp: time.Now()})
+	assert.False(t, feed.IsEmpty())
+}
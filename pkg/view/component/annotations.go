@@ -5,7 +5,11 @@ SPDX-License-Identifier: Apache-2.0
 
 package component
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
 
 // Annotations is a component representing key/value based annotations
 //
@@ -15,9 +19,43 @@ type Annotations struct {
 	Config AnnotationsConfig `json:"config"`
 }
 
+var _ Container = (*Annotations)(nil)
+
 // AnnotationsConfig is the contents of Annotations
 type AnnotationsConfig struct {
 	Annotations map[string]string `json:"annotations"`
+	// JSONEditors are editors for annotations whose values are JSON. They are
+	// only set if JSON detection is enabled.
+	JSONEditors map[string]Component `json:"jsonEditors,omitempty"`
+}
+
+// UnmarshalJSON unmarshals an annotations config from JSON.
+func (c *AnnotationsConfig) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Annotations map[string]string      `json:"annotations"`
+		JSONEditors map[string]TypedObject `json:"jsonEditors,omitempty"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	c.Annotations = x.Annotations
+	c.JSONEditors = nil
+
+	for key, to := range x.JSONEditors {
+		editor, err := to.ToComponent()
+		if err != nil {
+			return err
+		}
+
+		if c.JSONEditors == nil {
+			c.JSONEditors = map[string]Component{}
+		}
+		c.JSONEditors[key] = editor
+	}
+
+	return nil
 }
 
 // NewAnnotations creates a annotations component
@@ -30,6 +68,52 @@ func NewAnnotations(annotations map[string]string) *Annotations {
 	}
 }
 
+// DetectJSON sets whether annotations with JSON values are shown in a JSON
+// editor. Other annotations are shown as text.
+func (t *Annotations) DetectJSON(detect bool) {
+	t.Config.JSONEditors = nil
+	if !detect {
+		return
+	}
+
+	for key, value := range t.Config.Annotations {
+		if !isJSONDocument(value) {
+			continue
+		}
+
+		if t.Config.JSONEditors == nil {
+			t.Config.JSONEditors = map[string]Component{}
+		}
+		t.Config.JSONEditors[key] = NewJSONEditor(value)
+	}
+}
+
+// Children returns the JSON editors sorted by annotation key.
+func (t *Annotations) Children() []Component {
+	keys := make([]string, 0, len(t.Config.JSONEditors))
+	for key := range t.Config.JSONEditors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var children []Component
+	for _, key := range keys {
+		children = append(children, t.Config.JSONEditors[key])
+	}
+
+	return children
+}
+
+// isJSONDocument returns true if s is a JSON object or array.
+func isJSONDocument(s string) bool {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") && !strings.HasPrefix(s, "[") {
+		return false
+	}
+
+	return json.Valid([]byte(s))
+}
+
 // GetMetadata accesses the components metadata. Implements Component.
 func (t *Annotations) GetMetadata() Metadata {
 	return t.Metadata
@@ -84,3 +84,64 @@ func Test_Annotations_GetMetadata(t *testing.T) {
 
 	assert.Equal(t, "annotations", input.GetMetadata().Type)
 }
+
+func Test_Annotations_DetectJSON(t *testing.T) {
+	lastApplied := `{"apiVersion":"v1","kind":"Pod"}`
+	input := component.NewAnnotations(map[string]string{
+		"kubectl.kubernetes.io/last-applied-configuration": lastApplied,
+		"owner":   "team-a",
+		"ports":   "[80, 443]",
+		"invalid": "{not json",
+	})
+
+	input.DetectJSON(true)
+
+	expected := map[string]component.Component{
+		"kubectl.kubernetes.io/last-applied-configuration": component.NewJSONEditor(lastApplied),
+		"ports": component.NewJSONEditor("[80, 443]"),
+	}
+	assert.Equal(t, expected, input.Config.JSONEditors)
+	assert.Equal(t, "{\n  \"apiVersion\": \"v1\",\n  \"kind\": \"Pod\"\n}",
+		input.Config.JSONEditors["kubectl.kubernetes.io/last-applied-configuration"].String())
+	assert.Equal(t, "team-a", input.Config.Annotations["owner"])
+
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	var to component.TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	component.AssertEqual(t, input, got)
+
+	input.DetectJSON(false)
+	assert.Nil(t, input.Config.JSONEditors)
+}
+
+func Test_Annotations_Children(t *testing.T) {
+	annotations := component.NewAnnotations(map[string]string{
+		"b": `{"b":true}`,
+		"a": `["a"]`,
+		"c": "text",
+	})
+	annotations.DetectJSON(true)
+
+	assert.Equal(t, []component.Component{
+		component.NewJSONEditor(`["a"]`),
+		component.NewJSONEditor(`{"b":true}`),
+	}, annotations.Children())
+
+	list := component.NewList(nil, []component.Component{annotations})
+
+	stats := component.Stats(list)
+	assert.Equal(t, 4, stats.Total)
+	assert.Equal(t, 3, stats.MaxDepth)
+	assert.Equal(t, 2, stats.Counts[component.TypeJSONEditor])
+	assert.Len(t, component.FindByType(list, component.TypeJSONEditor), 2)
+
+	annotations.Config.JSONEditors["a"].SetAccessor("editor")
+	annotations.Config.JSONEditors["b"].SetAccessor("editor")
+	assert.Error(t, component.ValidateAccessors(list))
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Badge is a label and count with a status.
+type Badge struct {
+	Label  string `json:"label"`
+	Count  int    `json:"count"`
+	Status Status `json:"status"`
+}
+
+// BadgeRowConfig is the contents of BadgeRow.
+type BadgeRowConfig struct {
+	// Badges are the badges in the order they are shown.
+	Badges []Badge `json:"badges"`
+}
+
+// BadgeRow is a component showing a row of status badges.
+//
+// +octant:component
+type BadgeRow struct {
+	Base
+	Config BadgeRowConfig `json:"config"`
+}
+
+var _ Component = (*BadgeRow)(nil)
+
+// NewBadgeRow creates a badge row component.
+func NewBadgeRow() *BadgeRow {
+	return &BadgeRow{
+		Base: newBase(TypeBadgeRow, nil),
+		Config: BadgeRowConfig{
+			Badges: []Badge{},
+		},
+	}
+}
+
+// Add adds a badge to the end of the row.
+func (br *BadgeRow) Add(label string, count int, status Status) {
+	br.Config.Badges = append(br.Config.Badges, Badge{
+		Label:  label,
+		Count:  count,
+		Status: status,
+	})
+}
+
+// IsEmpty returns true if the row has no badges.
+func (br *BadgeRow) IsEmpty() bool {
+	return len(br.Config.Badges) == 0
+}
+
+type badgeRowMarshal BadgeRow
+
+// MarshalJSON implements json.Marshaler
+func (br *BadgeRow) MarshalJSON() ([]byte, error) {
+	m := badgeRowMarshal(*br)
+	m.Metadata.Type = TypeBadgeRow
+	return json.Marshal(&m)
+}
+
+// String returns the badges, e.g. "3 Running, 1 Pending".
+func (br *BadgeRow) String() string {
+	var parts []string
+	for _, badge := range br.Config.Badges {
+		parts = append(parts, fmt.Sprintf("%d %s", badge.Count, badge.Label))
+	}
+
+	return strings.Join(parts, ", ")
+}
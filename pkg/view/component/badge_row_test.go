@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadgeRow_Marshal(t *testing.T) {
+	br := NewBadgeRow()
+	assert.True(t, br.IsEmpty())
+
+	br.Add("Running", 3, StatusOK)
+	br.Add("Pending", 1, StatusWarning)
+	br.Add("Failed", 0, StatusError)
+	assert.False(t, br.IsEmpty())
+	assert.Equal(t, "3 Running, 1 Pending, 0 Failed", br.String())
+
+	data, err := json.Marshal(br)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "badgeRow"
+  },
+  "config": {
+    "badges": [
+      {"label": "Running", "count": 3, "status": "ok"},
+      {"label": "Pending", "count": 1, "status": "warning"},
+      {"label": "Failed", "count": 0, "status": "error"}
+    ]
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, br, got)
+}
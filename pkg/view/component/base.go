@@ -6,8 +6,14 @@ SPDX-License-Identifier: Apache-2.0
 package component
 
 const (
+	// TypeActivityFeed is an activity feed component.
+	TypeActivityFeed = "activityFeed"
 	// TypeAnnotations is an annotations component.
 	TypeAnnotations = "annotations"
+	// TypeBadgeRow is a badge row component.
+	TypeBadgeRow = "badgeRow"
+	// TypeBreadcrumb is a breadcrumb component.
+	TypeBreadcrumb = "breadcrumb"
 	// ButtonGroup is a button group component.
 	TypeButtonGroup = "buttonGroup"
 	// TypeCard is a card component.
@@ -16,54 +22,114 @@ const (
 	TypeCardList = "cardList"
 	// TypeCode is a code block component.
 	TypeCode = "codeBlock"
+	// TypeConditions is a conditions component.
+	TypeConditions = "conditions"
 	// TypeContainers is a container component.
 	TypeContainers = "containers"
+	// TypeCopyBlock is a copy block component.
+	TypeCopyBlock = "copyBlock"
+	// TypeCronSchedule is a cron schedule component.
+	TypeCronSchedule = "cronSchedule"
+	// TypeDescriptionList is a description list component.
+	TypeDescriptionList = "descriptionList"
 	// TypeDonutChart is a donut chart component.
 	TypeDonutChart = "donutChart"
 	// TypeEditor is an editor component.
 	TypeEditor = "editor"
+	// TypeEmbeddedResponse is an embedded content response component.
+	TypeEmbeddedResponse = "embeddedResponse"
+	// TypeEnvEditor is an environment variable editor component.
+	TypeEnvEditor = "envEditor"
 	// TypeError is an error component.
 	TypeError = "error"
+	// TypeEventTimeline is an event timeline component.
+	TypeEventTimeline = "eventTimeline"
 	// TypeExtension is an extension component.
 	TypeExtension = "extension"
 	// TypeExpressionSelector is an expression selector component.
 	TypeExpressionSelector = "expressionSelector"
+	// TypeFlameGraph is a flame graph component.
+	TypeFlameGraph = "flameGraph"
 	// TypeFlexLayout is a flex layout component.
 	TypeFlexLayout = "flexlayout"
 	// TypeGraphviz is a graphviz component.
 	TypeGraphviz = "graphviz"
 	// TypeGridActions is a grid actions component.
 	TypeGridActions = "gridActions"
+	// TypeHistogram is a histogram component.
+	TypeHistogram = "histogram"
 	// TypeIFrame is an iframe component.
 	TypeIFrame = "iframe"
+	// TypeImage is an image component.
+	TypeImage = "image"
+	// TypeJSONEditor is a JSON editor component.
+	TypeJSONEditor = "jsonEditor"
+	// TypeJSONPatch is a JSON patch component.
+	TypeJSONPatch = "jsonPatch"
 	// TypeLabels is a labels component.
 	TypeLabels = "labels"
 	// TypeLabelSelector is a label selector component.
 	TypeLabelSelector = "labelSelector"
+	// TypeLazyTabs is a lazy tabs component.
+	TypeLazyTabs = "lazyTabs"
+	// TypeLineChart is a line chart component.
+	TypeLineChart = "lineChart"
 	// TypeLink is a link component.
 	TypeLink = "link"
 	// TypeList is a list component.
 	TypeList = "list"
+	// TypeLiveCounter is a live counter component.
+	TypeLiveCounter = "liveCounter"
 	// TypeLoading is a loading component.
 	TypeLoading = "loading"
 	// TypeLogs is a logs component.
 	TypeLogs = "logs"
+	// TypeMasked is a masked component.
+	TypeMasked = "masked"
+	// TypeModal is a modal component.
+	TypeModal = "modal"
+	// TypeNetworkPolicyGraph is a network policy graph component.
+	TypeNetworkPolicyGraph = "networkPolicyGraph"
+	// TypeNotification is a notification component.
+	TypeNotification = "notification"
+	// TypeOperation is an operation component.
+	TypeOperation = "operation"
+	// TypeOwnerChain is an owner chain component.
+	TypeOwnerChain = "ownerChain"
+	// TypePhaseProgress is a phase progress component.
+	TypePhaseProgress = "phaseProgress"
 	// TypePodStatus is a pod status component.
 	TypePodStatus = "podStatus"
 	// TypePort is a port component.
 	TypePort = "port"
 	// TypePorts is a ports component.
 	TypePorts = "ports"
+	// TypeProgressBar is a progress bar component.
+	TypeProgressBar = "progressBar"
 	// TypeQuadrant is a quadrant component.
 	TypeQuadrant = "quadrant"
+	// TypeQuotaUsage is a quota usage component.
+	TypeQuotaUsage = "quotaUsage"
+	// TypeRegionDistribution is a region distribution component.
+	TypeRegionDistribution = "regionDistribution"
+	// TypeReleaseNotes is a release notes component.
+	TypeReleaseNotes = "releaseNotes"
+	// TypeResourceRequirements is a resource requirements component.
+	TypeResourceRequirements = "resourceRequirements"
 	// TypeResourceViewer is a resource viewer component.
 	TypeResourceViewer = "resourceViewer"
+	// TypeRolloutStatus is a rollout status component.
+	TypeRolloutStatus = "rolloutStatus"
 	// TypeSelectors is a selectors component.
 	TypeSelectors = "selectors"
 	// TypeSingleStat is a single stat component.
 	TypeSingleStat = "singleStat"
+	// TypeSparkline is a sparkline component.
+	TypeSparkline = "sparkline"
 	// TypeStepper is a stepper component.
 	TypeStepper = "stepper"
+	// TypeStructuredYAML is a structured YAML component.
+	TypeStructuredYAML = "structuredYAML"
 	// TypeSummary is a summary component.
 	TypeSummary = "summary"
 	// TypeTable is a table component.
@@ -72,8 +138,18 @@ const (
 	TypeTerminal = "terminal"
 	// TypeText is a text component.
 	TypeText = "text"
+	// TypeTextDiff is a text diff component.
+	TypeTextDiff = "textDiff"
+	// TypeTimeRangePicker is a time range picker component.
+	TypeTimeRangePicker = "timeRangePicker"
 	// TypeTimestamp is a timestamp component.
 	TypeTimestamp = "timestamp"
+	// TypeToggle is a toggle component.
+	TypeToggle = "toggle"
+	// TypeTreeView is a tree view component.
+	TypeTreeView = "treeView"
+	// TypeVersionMatrix is a version matrix component.
+	TypeVersionMatrix = "versionMatrix"
 	// TypeYAML is a YAML component.
 	TypeYAML = "yaml"
 )
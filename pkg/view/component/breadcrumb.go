@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// BreadcrumbSegment is a segment of a breadcrumb. If Ref is set, the segment
+// is a link.
+type BreadcrumbSegment struct {
+	Text string `json:"text"`
+	Ref  string `json:"ref,omitempty"`
+}
+
+// BreadcrumbConfig is the contents of Breadcrumb.
+type BreadcrumbConfig struct {
+	// Segments are the segments in order.
+	Segments []BreadcrumbSegment `json:"segments"`
+}
+
+// Breadcrumb is a component showing a path of segments, e.g. a namespace and
+// resource. It can be used in a title.
+//
+// +octant:component
+type Breadcrumb struct {
+	Base
+	Config BreadcrumbConfig `json:"config"`
+}
+
+var _ TitleComponent = (*Breadcrumb)(nil)
+
+// NewBreadcrumb creates a breadcrumb component.
+func NewBreadcrumb() *Breadcrumb {
+	return &Breadcrumb{
+		Base: newBase(TypeBreadcrumb, nil),
+		Config: BreadcrumbConfig{
+			Segments: []BreadcrumbSegment{},
+		},
+	}
+}
+
+// Add adds a segment to the end of the breadcrumb. If ref is blank, the
+// segment isn't a link.
+func (b *Breadcrumb) Add(text, ref string) {
+	b.Config.Segments = append(b.Config.Segments, BreadcrumbSegment{
+		Text: text,
+		Ref:  ref,
+	})
+}
+
+// IsEmpty returns true if the breadcrumb has no segments.
+func (b *Breadcrumb) IsEmpty() bool {
+	return len(b.Config.Segments) == 0
+}
+
+// SupportsTitle denotes this is a TitleComponent.
+func (b *Breadcrumb) SupportsTitle() {}
+
+type breadcrumbMarshal Breadcrumb
+
+// MarshalJSON implements json.Marshaler
+func (b *Breadcrumb) MarshalJSON() ([]byte, error) {
+	m := breadcrumbMarshal(*b)
+	m.Metadata.Type = TypeBreadcrumb
+	return json.Marshal(&m)
+}
+
+// String returns the segments separated by slashes.
+func (b *Breadcrumb) String() string {
+	var parts []string
+	for _, segment := range b.Config.Segments {
+		parts = append(parts, segment.Text)
+	}
+
+	return strings.Join(parts, " / ")
+}
@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreadcrumb_Marshal(t *testing.T) {
+	b := NewBreadcrumb()
+	assert.True(t, b.IsEmpty())
+
+	b.Add("default", "/overview/namespace/default")
+	b.Add("Deployments", "/overview/namespace/default/workloads/deployments")
+	b.Add("nginx", "")
+	assert.False(t, b.IsEmpty())
+	assert.Equal(t, "default / Deployments / nginx", b.String())
+
+	data, err := json.Marshal(b)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "breadcrumb"
+  },
+  "config": {
+    "segments": [
+      {"text": "default", "ref": "/overview/namespace/default"},
+      {"text": "Deployments", "ref": "/overview/namespace/default/workloads/deployments"},
+      {"text": "nginx"}
+    ]
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, b, got)
+}
+
+func TestBreadcrumb_title(t *testing.T) {
+	b := NewBreadcrumb()
+	b.Add("default", "/overview/namespace/default")
+	b.Add("nginx", "")
+
+	cr := NewContentResponse(Title(b))
+
+	data, err := json.Marshal(cr)
+	require.NoError(t, err)
+
+	var got ContentResponse
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	require.Len(t, got.Title, 1)
+	AssertEqual(t, b, got.Title[0])
+}
@@ -14,14 +14,17 @@ type CardConfig struct {
 	Actions []Action `json:"actions,omitempty"`
 	// Alert is the alert to show for the card.
 	Alert *Alert `json:"alert,omitempty"`
+	// Footer is shown below the body of the card.
+	Footer Component `json:"footer,omitempty"`
 }
 
 // UnmarshalJSON unmarshals a card config from JSON.
 func (c *CardConfig) UnmarshalJSON(data []byte) error {
 	x := struct {
-		Body    TypedObject `json:"body"`
-		Actions []Action    `json:"actions"`
-		Alert   *Alert      `json:"alert,omitempty"`
+		Body    TypedObject  `json:"body"`
+		Actions []Action     `json:"actions"`
+		Alert   *Alert       `json:"alert,omitempty"`
+		Footer  *TypedObject `json:"footer,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -37,6 +40,14 @@ func (c *CardConfig) UnmarshalJSON(data []byte) error {
 	c.Actions = x.Actions
 	c.Alert = x.Alert
 
+	if x.Footer != nil {
+		footer, err := x.Footer.ToComponent()
+		if err != nil {
+			return err
+		}
+		c.Footer = footer
+	}
+
 	return nil
 }
 
@@ -71,6 +82,24 @@ func (c *Card) SetAlert(alert Alert) {
 	c.Config.Alert = &alert
 }
 
+// Children returns the body and footer of the card.
+func (c *Card) Children() []Component {
+	var children []Component
+	if c.Config.Body != nil {
+		children = append(children, c.Config.Body)
+	}
+	if c.Config.Footer != nil {
+		children = append(children, c.Config.Footer)
+	}
+
+	return children
+}
+
+// SetFooter sets the footer for the card.
+func (c *Card) SetFooter(footer Component) {
+	c.Config.Footer = footer
+}
+
 type cardMarshal Card
 
 // MarshalJSON marshals a card to JSON.
@@ -135,6 +164,16 @@ func (c *CardList) AddCard(card Card) {
 	c.Config.Cards = append(c.Config.Cards, card)
 }
 
+// Children returns the cards in the list.
+func (c *CardList) Children() []Component {
+	var children []Component
+	for i := range c.Config.Cards {
+		children = append(children, &c.Config.Cards[i])
+	}
+
+	return children
+}
+
 type cardListMarshal CardList
 
 // MarshalJSON marshals a card list to JSON.
@@ -1,7 +1,11 @@
 package component
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestCard_SetAlert(t *testing.T) {
@@ -61,3 +65,30 @@ func TestCardList_AddCard(t *testing.T) {
 
 	AssertEqual(t, expected, cardList)
 }
+
+func TestCard_SetFooter(t *testing.T) {
+	card := NewCard(TitleFromString("card"))
+	card.SetBody(NewText("body"))
+
+	footer := NewList(nil, []Component{
+		NewTimestamp(time.Unix(1599158391, 0)),
+		NewLink("", "Refresh", "/refresh"),
+	})
+	card.SetFooter(footer)
+
+	data, err := json.Marshal(card)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	footerType, err := to.GetString("footer.metadata.type")
+	require.NoError(t, err)
+	require.Equal(t, TypeList, footerType)
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, card, got)
+	require.IsType(t, &List{}, got.(*Card).Config.Footer)
+}
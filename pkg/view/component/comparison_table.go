@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "sort"
+
+// NewComparisonTable creates a table comparing the values of keys before and
+// after a change. Each key in either map has a row, sorted by key. Rows whose
+// values differ have a warning row status.
+func NewComparisonTable(title string, before, after map[string]string) *Table {
+	keys := map[string]bool{}
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+
+	var sorted []string
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	table := NewTable(title, "There are no differences", NewTableCols("Key", "Before", "After"))
+
+	for _, key := range sorted {
+		beforeValue, inBefore := before[key]
+		afterValue, inAfter := after[key]
+
+		row := TableRow{
+			"Key":    NewText(key),
+			"Before": NewText(beforeValue),
+			"After":  NewText(afterValue),
+		}
+
+		if inBefore != inAfter || beforeValue != afterValue {
+			row[TableRowStatusKey] = NewText(string(StatusWarning))
+		}
+
+		table.Add(row)
+	}
+
+	return table
+}
@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComparisonTable(t *testing.T) {
+	before := map[string]string{
+		"replicas": "2",
+		"image":    "nginx:1.18",
+		"removed":  "yes",
+	}
+	after := map[string]string{
+		"replicas": "2",
+		"image":    "nginx:1.19",
+		"added":    "yes",
+	}
+
+	got := NewComparisonTable("Changes", before, after)
+
+	expected := NewTableWithRows("Changes", "There are no differences", NewTableCols("Key", "Before", "After"), []TableRow{
+		{
+			"Key":             NewText("added"),
+			"Before":          NewText(""),
+			"After":           NewText("yes"),
+			TableRowStatusKey: NewText("warning"),
+		},
+		{
+			"Key":             NewText("image"),
+			"Before":          NewText("nginx:1.18"),
+			"After":           NewText("nginx:1.19"),
+			TableRowStatusKey: NewText("warning"),
+		},
+		{
+			"Key":             NewText("removed"),
+			"Before":          NewText("yes"),
+			"After":           NewText(""),
+			TableRowStatusKey: NewText("warning"),
+		},
+		{
+			"Key":    NewText("replicas"),
+			"Before": NewText("2"),
+			"After":  NewText("2"),
+		},
+	})
+
+	AssertEqual(t, expected, got)
+
+	data, err := json.Marshal(got)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	roundTrip, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, got, roundTrip)
+}
@@ -6,8 +6,15 @@ SPDX-License-Identifier: Apache-2.0
 package component
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -24,6 +31,12 @@ type ContentResponse struct {
 	Components         []Component      `json:"viewComponents"`
 	ExtensionComponent Component        `json:"extensionComponent,omitempty"`
 	ButtonGroup        *ButtonGroup     `json:"buttonGroup,omitempty"`
+	SchemaVersion      string           `json:"schemaVersion,omitempty"`
+	Footer             []Component      `json:"footer,omitempty"`
+
+	// notTitleType is the type of the first component in the title which
+	// wasn't a title component when the response was unmarshalled.
+	notTitleType string
 }
 
 // NewContentResponse creates an instance of ContentResponse.
@@ -44,6 +57,57 @@ func (c *ContentResponse) Add(components ...Component) {
 	}
 }
 
+// SetFooter replaces the footer of a content response. The footer is shown
+// below the components, e.g. for pagination controls. Nil components will be
+// ignored.
+func (c *ContentResponse) SetFooter(components ...Component) {
+	c.Footer = nil
+	for i := range components {
+		if components[i] != nil {
+			c.Footer = append(c.Footer, components[i])
+		}
+	}
+}
+
+// SetTitle replaces the title of a content response.
+func (c *ContentResponse) SetTitle(components ...TitleComponent) {
+	c.Title = components
+}
+
+// AppendTitle appends components to the title of a content response.
+func (c *ContentResponse) AppendTitle(components ...TitleComponent) {
+	c.Title = append(c.Title, components...)
+}
+
+// SetSchemaVersion sets the component schema version of a content response.
+// Clients can use the version to determine which components they support.
+func (c *ContentResponse) SetSchemaVersion(v string) {
+	c.SchemaVersion = v
+}
+
+// SortComponents sorts the components of a content response using less.
+// Components which are equal keep their original order.
+func (c *ContentResponse) SortComponents(less func(a, b Component) bool) {
+	sort.SliceStable(c.Components, func(i, j int) bool {
+		return less(c.Components[i], c.Components[j])
+	})
+}
+
+// ByTitle compares components by their metadata titles.
+func ByTitle(a, b Component) bool {
+	return titleString(a.GetMetadata().Title) < titleString(b.GetMetadata().Title)
+}
+
+// titleString returns the text of a title.
+func titleString(title []TitleComponent) string {
+	var parts []string
+	for _, tc := range title {
+		parts = append(parts, tc.String())
+	}
+
+	return strings.Join(parts, " ")
+}
+
 // SetExtension adds zero or more components to an extension content response.
 func (c *ContentResponse) SetExtension(component *Extension) {
 	c.ExtensionComponent = component
@@ -55,24 +119,95 @@ func (c *ContentResponse) AddButton(name string, payload action.Payload, buttonO
 	c.ButtonGroup.AddButton(button)
 }
 
+// HashOption is an option for configuring how a content response is hashed.
+type HashOption func(o *hashOptions)
+
+type hashOptions struct {
+	ignoreAccessors bool
+}
+
+// IgnoreAccessors is a hash option which ignores component accessors. Content
+// responses which only differ by accessor will have the same hash.
+func IgnoreAccessors() HashOption {
+	return func(o *hashOptions) {
+		o.ignoreAccessors = true
+	}
+}
+
+// Hash returns a stable hash of the content response's JSON representation.
+// It can be used as an ETag to avoid sending unchanged content.
+func (c *ContentResponse) Hash(options ...HashOption) (string, error) {
+	opts := hashOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal content response")
+	}
+
+	if opts.ignoreAccessors {
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return "", errors.Wrap(err, "unmarshal content response")
+		}
+
+		removeAccessors(v)
+
+		// encoding/json sorts map keys, so the result is canonical.
+		data, err = json.Marshal(v)
+		if err != nil {
+			return "", errors.Wrap(err, "marshal content response")
+		}
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// removeAccessors removes accessors from component metadata in a decoded
+// JSON value.
+func removeAccessors(v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if metadata, ok := t["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "accessor")
+		}
+		for _, value := range t {
+			removeAccessors(value)
+		}
+	case []interface{}:
+		for _, value := range t {
+			removeAccessors(value)
+		}
+	}
+}
+
 // UnmarshalJSON unmarshals a content response from JSON.
 func (c *ContentResponse) UnmarshalJSON(data []byte) error {
 	stage := struct {
-		Title      []TypedObject `json:"title,omitempty"`
-		Components []TypedObject `json:"viewComponents,omitempty"`
+		Title         []TypedObject `json:"title,omitempty"`
+		Components    []TypedObject `json:"viewComponents,omitempty"`
+		SchemaVersion string        `json:"schemaVersion,omitempty"`
+		Footer        []TypedObject `json:"footer,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &stage); err != nil {
 		return err
 	}
 
+	c.notTitleType = ""
 	for _, t := range stage.Title {
-		title, err := getTitleByUnmarshalInterface(t.Config)
+		tvc, ok, err := t.toTitleComponent()
 		if err != nil {
 			return err
 		}
+		if !ok && c.notTitleType == "" {
+			c.notTitleType = t.Metadata.Type
+		}
 
-		c.Title = Title(NewText(title))
+		c.Title = append(c.Title, tvc)
 	}
 
 	for _, to := range stage.Components {
@@ -84,20 +219,18 @@ func (c *ContentResponse) UnmarshalJSON(data []byte) error {
 		c.Components = append(c.Components, vc)
 	}
 
-	return nil
-}
+	for _, to := range stage.Footer {
+		vc, err := to.ToComponent()
+		if err != nil {
+			return err
+		}
 
-func getTitleByUnmarshalInterface(config json.RawMessage) (string, error) {
-	var objmap map[string]interface{}
-	if err := json.Unmarshal(config, &objmap); err != nil {
-		return "", err
+		c.Footer = append(c.Footer, vc)
 	}
 
-	if value, ok := objmap["value"].(string); ok {
-		return value, nil
-	}
+	c.SchemaVersion = stage.SchemaVersion
 
-	return "", fmt.Errorf("title does not have a value")
+	return nil
 }
 
 type TypedObject struct {
@@ -105,7 +238,18 @@ type TypedObject struct {
 	Metadata Metadata        `json:"metadata,omitempty"`
 }
 
+// ToComponent converts the object to a component. A ComponentTooLargeError is
+// returned if the config is larger than MaxComponentBytes().
 func (to *TypedObject) ToComponent() (Component, error) {
+	maxBytes := MaxComponentBytes()
+	if size := len(to.Config); size > maxBytes {
+		return nil, &ComponentTooLargeError{
+			Type: to.Metadata.Type,
+			Size: size,
+			Max:  maxBytes,
+		}
+	}
+
 	o, err := unmarshal(*to)
 	if err != nil {
 		return nil, err
@@ -120,11 +264,196 @@ func (to *TypedObject) ToComponent() (Component, error) {
 	return vc, nil
 }
 
+// toTitleComponent converts the object to a title component. Components
+// which aren't title components are replaced with text summarizing them and
+// ok is false. Use UnmarshalStrict to reject them instead.
+func (to *TypedObject) toTitleComponent() (TitleComponent, bool, error) {
+	vc, err := to.ToComponent()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal-ing title")
+	}
+
+	if tvc, ok := vc.(TitleComponent); ok {
+		return tvc, true, nil
+	}
+
+	return NewText(componentSummary(vc)), false, nil
+}
+
+// UnmarshalStrict is like json.Unmarshal, but returns an error wrapping
+// ErrNotTitleComponent if the title of a content response or of any
+// component decoded into v contains a component which isn't a title
+// component, instead of replacing it with text. v is typically a
+// *ContentResponse, *Metadata, or a pointer to a component. The configs of
+// TypedObjects aren't decoded, so they aren't checked.
+func UnmarshalStrict(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	if typ := findNotTitleType(reflect.ValueOf(v)); typ != "" {
+		return errors.Wrapf(ErrNotTitleComponent, "type %q", typ)
+	}
+
+	return nil
+}
+
+var (
+	metadataType        = reflect.TypeOf(Metadata{})
+	contentResponseType = reflect.TypeOf(ContentResponse{})
+)
+
+// findNotTitleType returns the type of the first component found in v's
+// metadata or content response titles which wasn't a title component when
+// it was unmarshalled.
+func findNotTitleType(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		return findNotTitleType(v.Elem())
+	case reflect.Struct:
+		if v.Type() == metadataType || v.Type() == contentResponseType {
+			if typ := v.FieldByName("notTitleType").String(); typ != "" {
+				return typ
+			}
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if typ := findNotTitleType(v.Field(i)); typ != "" {
+				return typ
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return ""
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			if typ := findNotTitleType(v.Index(i)); typ != "" {
+				return typ
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if typ := findNotTitleType(iter.Value()); typ != "" {
+				return typ
+			}
+		}
+	}
+
+	return ""
+}
+
+// componentSummary returns a short description of a component. It is the
+// component's String value, falling back to its title and then its type.
+func componentSummary(vc Component) string {
+	if s := vc.String(); s != "" {
+		return s
+	}
+
+	if s := titleString(vc.GetMetadata().Title); s != "" {
+		return s
+	}
+
+	return vc.GetMetadata().Type
+}
+
+// GetString returns the string at a dotted path in the object's config. Path
+// segments which are integers index into arrays, e.g. "sections.0.header".
+func (to *TypedObject) GetString(path string) (string, error) {
+	v, err := to.lookup(path)
+	if err != nil {
+		return "", err
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.Errorf("value at %q is %T, not a string", path, v)
+	}
+
+	return s, nil
+}
+
+// GetInt returns the integer at a dotted path in the object's config. Path
+// segments which are integers index into arrays, e.g. "items.0.count".
+func (to *TypedObject) GetInt(path string) (int, error) {
+	v, err := to.lookup(path)
+	if err != nil {
+		return 0, err
+	}
+
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, errors.Errorf("value at %q is %T, not a number", path, v)
+	}
+
+	i, err := strconv.Atoi(n.String())
+	if err != nil {
+		return 0, errors.Errorf("value at %q is not an integer", path)
+	}
+
+	return i, nil
+}
+
+// lookup finds the value at a dotted path in the object's config.
+func (to *TypedObject) lookup(path string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(to.Config))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, errors.Wrap(err, "decode config")
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			value, ok := t[segment]
+			if !ok {
+				return nil, errors.Errorf("key %q in path %q not found", segment, path)
+			}
+			v = value
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(t) {
+				return nil, errors.Errorf("index %q in path %q is invalid", segment, path)
+			}
+			v = t[i]
+		default:
+			return nil, errors.Errorf("segment %q in path %q can't be navigated", segment, path)
+		}
+	}
+
+	return v, nil
+}
+
 // Metadata collects common fields describing Components
 type Metadata struct {
-	Type     string           `json:"type"`
-	Title    []TitleComponent `json:"title,omitempty"`
-	Accessor string           `json:"accessor,omitempty"`
+	Type       string           `json:"type"`
+	Title      []TitleComponent `json:"title,omitempty"`
+	Accessor   string           `json:"accessor,omitempty"`
+	HelpText   string           `json:"helpText,omitempty"`
+	TTLSeconds int64            `json:"ttlSeconds,omitempty"`
+	// ResourceRef is the Kubernetes object the component represents.
+	ResourceRef *ResourceRef `json:"resourceRef,omitempty"`
+	// RequiresFeature is the feature a client must have enabled to render
+	// the component. Clients without the feature skip the component.
+	RequiresFeature string `json:"requiresFeature,omitempty"`
+
+	// notTitleType is the type of the first component in the title which
+	// wasn't a title component when the metadata was unmarshalled.
+	notTitleType string
+}
+
+// ResourceRef refers to a Kubernetes object.
+type ResourceRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
 }
 
 // SetTitleText sets the title using text components.
@@ -138,11 +467,39 @@ func (m *Metadata) SetTitleText(parts ...string) {
 	m.Title = titleComponents
 }
 
+// SetHelp sets the help text for the component.
+func (m *Metadata) SetHelp(text string) {
+	m.HelpText = text
+}
+
+// SetTTL sets how long the client can cache the component. The TTL is
+// truncated to seconds.
+func (m *Metadata) SetTTL(d time.Duration) {
+	m.TTLSeconds = int64(d / time.Second)
+}
+
+// RequireFeature only renders the component on clients which have the named
+// feature enabled. This allows views to include components older clients
+// don't support.
+func (m *Metadata) RequireFeature(name string) {
+	m.RequiresFeature = name
+}
+
+// SetResourceRef sets the Kubernetes object the component represents. The
+// UI uses the reference to navigate to the object.
+func (m *Metadata) SetResourceRef(ref ResourceRef) {
+	m.ResourceRef = &ref
+}
+
 func (m *Metadata) UnmarshalJSON(data []byte) error {
 	x := struct {
-		Type     string        `json:"type,omitempty"`
-		Title    []TypedObject `json:"title,omitempty"`
-		Accessor string        `json:"accessor,omitempty"`
+		Type            string        `json:"type,omitempty"`
+		Title           []TypedObject `json:"title,omitempty"`
+		Accessor        string        `json:"accessor,omitempty"`
+		HelpText        string        `json:"helpText,omitempty"`
+		TTLSeconds      int64         `json:"ttlSeconds,omitempty"`
+		ResourceRef     *ResourceRef  `json:"resourceRef,omitempty"`
+		RequiresFeature string        `json:"requiresFeature,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -151,16 +508,19 @@ func (m *Metadata) UnmarshalJSON(data []byte) error {
 
 	m.Type = x.Type
 	m.Accessor = x.Accessor
+	m.HelpText = x.HelpText
+	m.TTLSeconds = x.TTLSeconds
+	m.ResourceRef = x.ResourceRef
+	m.RequiresFeature = x.RequiresFeature
 
+	m.notTitleType = ""
 	for _, title := range x.Title {
-		vc, err := title.ToComponent()
+		tvc, ok, err := title.toTitleComponent()
 		if err != nil {
-			return errors.Wrap(err, "unmarshal-ing title")
+			return err
 		}
-
-		tvc, ok := vc.(TitleComponent)
-		if !ok {
-			return errors.New("component in title isn't a title view component")
+		if !ok && m.notTitleType == "" {
+			m.notTitleType = title.Metadata.Type
 		}
 
 		m.Title = append(m.Title, tvc)
@@ -6,10 +6,13 @@
 package component
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
@@ -57,3 +60,268 @@ func TestContentResponse_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadata_SetHelp(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("name"))
+	table.SetHelp("Pods in the namespace")
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+	require.Equal(t, "Pods in the namespace", to.Metadata.HelpText)
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+	require.Equal(t, "Pods in the namespace", got.GetMetadata().HelpText)
+}
+
+func TestContentResponse_Hash(t *testing.T) {
+	newResponse := func(text, accessor string) *ContentResponse {
+		table := NewTableWithRows("table", "placeholder", NewTableCols("name"), []TableRow{
+			{"name": NewText(text)},
+		})
+		table.SetAccessor(accessor)
+
+		cr := NewContentResponse(TitleFromString("title"))
+		cr.Add(table)
+		return cr
+	}
+
+	hash := func(cr *ContentResponse, options ...HashOption) string {
+		h, err := cr.Hash(options...)
+		require.NoError(t, err)
+		return h
+	}
+
+	a := newResponse("nginx", "pods")
+	b := newResponse("nginx", "pods")
+	require.Equal(t, hash(a), hash(b))
+
+	changed := newResponse("redis", "pods")
+	require.NotEqual(t, hash(a), hash(changed))
+
+	otherAccessor := newResponse("nginx", "other")
+	require.NotEqual(t, hash(a), hash(otherAccessor))
+	require.Equal(t, hash(a, IgnoreAccessors()), hash(otherAccessor, IgnoreAccessors()))
+	require.NotEqual(t, hash(a, IgnoreAccessors()), hash(changed, IgnoreAccessors()))
+}
+
+func TestContentResponse_AppendTitle(t *testing.T) {
+	cr := NewContentResponse(TitleFromString("Workloads"))
+	cr.AppendTitle(NewLink("", "Pods", "/pods"))
+
+	data, err := json.Marshal(cr)
+	require.NoError(t, err)
+
+	cr.ButtonGroup = nil
+
+	var got ContentResponse
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	AssertContentResponseEquals(t, *cr, got)
+	require.Len(t, got.Title, 2)
+	require.Equal(t, "Workloads", got.Title[0].String())
+
+	link, ok := got.Title[1].(*Link)
+	require.True(t, ok)
+	require.Equal(t, "/pods", link.Ref())
+}
+
+func TestContentResponse_SetTitle(t *testing.T) {
+	cr := NewContentResponse(TitleFromString("Workloads"))
+	cr.SetTitle(NewText("Pods"))
+
+	require.Equal(t, TitleFromString("Pods"), cr.Title)
+}
+
+func TestContentResponse_SetSchemaVersion(t *testing.T) {
+	cr := NewContentResponse(TitleFromString("Workloads"))
+
+	data, err := json.Marshal(cr)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "schemaVersion")
+
+	cr.SetSchemaVersion("v1")
+
+	data, err = json.Marshal(cr)
+	require.NoError(t, err)
+
+	var got ContentResponse
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, "v1", got.SchemaVersion)
+}
+
+func TestContentResponse_SetFooter(t *testing.T) {
+	cr := NewContentResponse(TitleFromString("Workloads"))
+	cr.Add(NewText("body"))
+	cr.SetFooter(NewText("page 1 of 3"), nil, NewLink("", "next", "/workloads?page=2"))
+
+	require.Len(t, cr.Footer, 2)
+
+	data, err := json.Marshal(cr)
+	require.NoError(t, err)
+
+	var got ContentResponse
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, cr.Footer, got.Footer)
+	require.Equal(t, cr.Components, got.Components)
+}
+
+func TestContentResponse_SortComponents(t *testing.T) {
+	beta := NewText("beta")
+	beta.SetMetadata(Metadata{Title: TitleFromString("b")})
+	alpha := NewText("alpha")
+	alpha.SetMetadata(Metadata{Title: TitleFromString("a")})
+	untitled := NewText("untitled")
+	otherBeta := NewText("other beta")
+	otherBeta.SetMetadata(Metadata{Title: TitleFromString("b")})
+
+	cr := NewContentResponse(nil)
+	cr.Add(beta, alpha, otherBeta, untitled)
+	cr.SortComponents(ByTitle)
+
+	expected := []Component{untitled, alpha, beta, otherBeta}
+	require.Equal(t, expected, cr.Components)
+}
+
+func TestTypedObject_GetString(t *testing.T) {
+	to := TypedObject{
+		Config: json.RawMessage(`{"title":"pods","sections":[{"header":"Name","count":3}],"nested":{"value":"x"}}`),
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+		wantErr  bool
+	}{
+		{name: "top level", path: "title", expected: "pods"},
+		{name: "nested", path: "nested.value", expected: "x"},
+		{name: "array index", path: "sections.0.header", expected: "Name"},
+		{name: "missing key", path: "nested.missing", wantErr: true},
+		{name: "index out of range", path: "sections.1.header", wantErr: true},
+		{name: "not a string", path: "sections.0.count", wantErr: true},
+		{name: "through a scalar", path: "title.value", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := to.GetString(tt.path)
+			testutil.RequireErrorOrNot(t, tt.wantErr, err, func() {
+				require.Equal(t, tt.expected, got)
+			})
+		})
+	}
+}
+
+func TestTypedObject_GetInt(t *testing.T) {
+	to := TypedObject{
+		Config: json.RawMessage(`{"value":{"count":42,"ratio":0.5,"text":"a"}}`),
+	}
+
+	got, err := to.GetInt("value.count")
+	require.NoError(t, err)
+	require.Equal(t, 42, got)
+
+	_, err = to.GetInt("value.ratio")
+	require.Error(t, err)
+
+	_, err = to.GetInt("value.text")
+	require.Error(t, err)
+}
+
+func TestMetadata_SetTTL(t *testing.T) {
+	text := NewText("cached")
+	text.SetTTL(30 * time.Second)
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+	require.Equal(t, int64(30), got.GetMetadata().TTLSeconds)
+}
+
+func TestMetadata_SetResourceRef(t *testing.T) {
+	ref := ResourceRef{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "nginx",
+		Namespace:  "default",
+	}
+
+	text := NewText("nginx")
+	text.SetResourceRef(ref)
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+	require.NotNil(t, got.GetMetadata().ResourceRef)
+	require.Equal(t, ref, *got.GetMetadata().ResourceRef)
+}
+
+func TestMetadata_UnmarshalJSON_nonTitleComponent(t *testing.T) {
+	table, err := json.Marshal(NewTable("pods", "placeholder", NewTableCols("name")))
+	require.NoError(t, err)
+
+	data := []byte(`{"type":"text","title":[` + string(table) + `]}`)
+
+	got := Metadata{}
+	require.NoError(t, got.UnmarshalJSON(data))
+	require.Len(t, got.Title, 1)
+	require.Equal(t, NewText("pods"), got.Title[0])
+
+	err = UnmarshalStrict(data, &Metadata{})
+	require.True(t, errors.Is(err, ErrNotTitleComponent))
+
+	// Titles of nested components are checked.
+	nested := []byte(`{"viewComponents":[{"metadata":{"type":"flexlayout"},"config":{"sections":[[{"width":24,"view":{"metadata":` + string(data) + `,"config":{"value":"v"}}}]]}}]}`)
+	err = UnmarshalStrict(nested, &ContentResponse{})
+	require.True(t, errors.Is(err, ErrNotTitleComponent))
+
+	// Config values named title aren't components' titles.
+	config := []byte(`{"viewComponents":[{"metadata":{"type":"text"},"config":{"value":"v","title":[{"metadata":{"type":"table"}}]}}]}`)
+	require.NoError(t, UnmarshalStrict(config, &ContentResponse{}))
+
+	err = UnmarshalStrict([]byte(`{"title":[`+string(table)+`]}`), &ContentResponse{})
+	require.True(t, errors.Is(err, ErrNotTitleComponent))
+
+	title := NewText("nginx")
+	title.SetTitleText("pods")
+	text, err := json.Marshal(title)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, UnmarshalStrict(text, &to))
+	require.Equal(t, TypeText, to.Metadata.Type)
+}
+
+func TestMetadata_RequireFeature(t *testing.T) {
+	text := NewText("beta")
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "requiresFeature")
+
+	text.RequireFeature("lineCharts")
+
+	data, err = json.Marshal(text)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+	require.Equal(t, "lineCharts", got.GetMetadata().RequiresFeature)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Condition is a Kubernetes object condition.
+type Condition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+	// Severity determines how the condition is colored. If it is not set,
+	// it is derived from Status.
+	Severity Status `json:"severity"`
+}
+
+// ConditionsConfig is the contents of Conditions.
+type ConditionsConfig struct {
+	Conditions []Condition `json:"conditions"`
+}
+
+// Conditions is a component which renders Kubernetes object conditions.
+//
+// +octant:component
+type Conditions struct {
+	Base
+	Config ConditionsConfig `json:"config"`
+}
+
+var _ Component = (*Conditions)(nil)
+
+// NewConditions creates a conditions component.
+func NewConditions(conditions ...Condition) *Conditions {
+	c := &Conditions{
+		Base: newBase(TypeConditions, TitleFromString("Conditions")),
+	}
+
+	for _, condition := range conditions {
+		if condition.Severity == "" {
+			condition.Severity = conditionSeverity(condition.Status)
+		}
+		c.Config.Conditions = append(c.Config.Conditions, condition)
+	}
+
+	return c
+}
+
+// conditionSeverity converts a condition status to a Status.
+func conditionSeverity(status string) Status {
+	switch status {
+	case "True":
+		return StatusOK
+	case "False":
+		return StatusError
+	default:
+		return StatusWarning
+	}
+}
+
+// IsEmpty returns true if there are no conditions.
+func (c *Conditions) IsEmpty() bool {
+	return len(c.Config.Conditions) == 0
+}
+
+type conditionsMarshal Conditions
+
+// MarshalJSON implements json.Marshaler
+func (c *Conditions) MarshalJSON() ([]byte, error) {
+	m := conditionsMarshal(*c)
+	m.Metadata.Type = TypeConditions
+	return json.Marshal(&m)
+}
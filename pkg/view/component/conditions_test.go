@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConditions_severity(t *testing.T) {
+	conditions := NewConditions(
+		Condition{Type: "Available", Status: "True"},
+		Condition{Type: "Progressing", Status: "False", Reason: "ProgressDeadlineExceeded"},
+		Condition{Type: "ReplicaFailure", Status: "Unknown"},
+		Condition{Type: "DiskPressure", Status: "True", Severity: StatusError},
+	)
+
+	var got []Status
+	for _, condition := range conditions.Config.Conditions {
+		got = append(got, condition.Severity)
+	}
+
+	expected := []Status{StatusOK, StatusError, StatusWarning, StatusError}
+	assert.Equal(t, expected, got)
+}
+
+func TestConditions_Marshal(t *testing.T) {
+	lastTransition := time.Date(2020, 9, 3, 14, 39, 51, 0, time.UTC)
+	conditions := NewConditions(
+		Condition{
+			Type:               "Available",
+			Status:             "True",
+			Reason:             "MinimumReplicasAvailable",
+			Message:            "Deployment has minimum availability.",
+			LastTransitionTime: lastTransition,
+		},
+	)
+
+	data, err := json.Marshal(conditions)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, conditions, got)
+	assert.False(t, got.IsEmpty())
+	assert.True(t, NewConditions().IsEmpty())
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// CopyBlockConfig is the contents of CopyBlock.
+type CopyBlockConfig struct {
+	// Command is the command which is shown and copied.
+	Command string `json:"command"`
+}
+
+// CopyBlock is a component showing a command with a button to copy it to the
+// clipboard.
+//
+// +octant:component
+type CopyBlock struct {
+	Base
+	Config CopyBlockConfig `json:"config"`
+}
+
+var _ Component = (*CopyBlock)(nil)
+
+// NewCopyBlock creates a copy block component. The command is copied exactly
+// as it is given.
+func NewCopyBlock(command string) *CopyBlock {
+	return &CopyBlock{
+		Base: newBase(TypeCopyBlock, nil),
+		Config: CopyBlockConfig{
+			Command: command,
+		},
+	}
+}
+
+// IsEmpty returns true if the command is blank.
+func (cb *CopyBlock) IsEmpty() bool {
+	return strings.TrimSpace(cb.Config.Command) == ""
+}
+
+type copyBlockMarshal CopyBlock
+
+// MarshalJSON implements json.Marshaler
+func (cb *CopyBlock) MarshalJSON() ([]byte, error) {
+	m := copyBlockMarshal(*cb)
+	m.Metadata.Type = TypeCopyBlock
+	return json.Marshal(&m)
+}
+
+// String returns the command.
+func (cb *CopyBlock) String() string {
+	return cb.Config.Command
+}
@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyBlock_IsEmpty(t *testing.T) {
+	assert.True(t, NewCopyBlock("").IsEmpty())
+	assert.True(t, NewCopyBlock(" \n\t").IsEmpty())
+	assert.False(t, NewCopyBlock("kubectl get pods").IsEmpty())
+}
+
+func TestCopyBlock_Marshal(t *testing.T) {
+	command := `kubectl get pods -o jsonpath='{.items[*].metadata.name}' -l "app=<nginx>"`
+	cb := NewCopyBlock(command)
+
+	data, err := json.Marshal(cb)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, cb, got)
+	assert.Equal(t, command, got.(*CopyBlock).Config.Command)
+}
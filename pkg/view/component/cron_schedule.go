@@ -0,0 +1,265 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultCronRuns is the number of next runs computed by NewCronSchedule.
+const DefaultCronRuns = 5
+
+// CronScheduleConfig is the contents of CronSchedule.
+type CronScheduleConfig struct {
+	// Expression is the cron expression.
+	Expression string `json:"expression"`
+	// NextRuns are the next times the schedule fires in seconds since epoch.
+	NextRuns []int64 `json:"nextRuns"`
+}
+
+// CronSchedule is a component showing a cron expression and the next times
+// it fires.
+//
+// +octant:component
+type CronSchedule struct {
+	Base
+	Config CronScheduleConfig `json:"config"`
+
+	schedule *cronSpec
+}
+
+var _ Component = (*CronSchedule)(nil)
+
+// NewCronSchedule creates a cron schedule component with the next
+// DefaultCronRuns runs after now. The expression has five fields (minute,
+// hour, day of month, month, day of week) which can be numbers, ranges, lists,
+// and steps, or is one of @yearly, @monthly, @weekly, @daily, or @hourly.
+func NewCronSchedule(expr string) (*CronSchedule, error) {
+	spec, err := parseCron(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &CronSchedule{
+		Base: newBase(TypeCronSchedule, nil),
+		Config: CronScheduleConfig{
+			Expression: expr,
+		},
+		schedule: spec,
+	}
+	cs.SetNextRuns(time.Now(), DefaultCronRuns)
+
+	return cs, nil
+}
+
+// SetNextRuns replaces the next runs with the first n runs after from. Runs
+// are computed in from's location.
+func (cs *CronSchedule) SetNextRuns(from time.Time, n int) {
+	cs.Config.NextRuns = []int64{}
+	if cs.schedule == nil {
+		return
+	}
+
+	t := from
+	for i := 0; i < n; i++ {
+		next, ok := cs.schedule.next(t)
+		if !ok {
+			return
+		}
+
+		cs.Config.NextRuns = append(cs.Config.NextRuns, next.Unix())
+		t = next
+	}
+}
+
+// String returns the cron expression.
+func (cs *CronSchedule) String() string {
+	return cs.Config.Expression
+}
+
+type cronScheduleMarshal CronSchedule
+
+// MarshalJSON implements json.Marshaler
+func (cs *CronSchedule) MarshalJSON() ([]byte, error) {
+	m := cronScheduleMarshal(*cs)
+	m.Metadata.Type = TypeCronSchedule
+	return json.Marshal(&m)
+}
+
+// cronSpec is a parsed cron expression. Each field is a bit set of the
+// values it matches.
+type cronSpec struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar are true if the day fields are unrestricted. If
+	// both are restricted, a day matches if either matches.
+	domStar, dowStar bool
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseCron parses a five field cron expression or macro.
+func parseCron(expr string) (*cronSpec, error) {
+	fieldsExpr := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[fieldsExpr]; ok {
+		fieldsExpr = macro
+	}
+
+	fields := strings.Fields(fieldsExpr)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("cron expression %q has %d fields, expected 5", expr, len(fields))
+	}
+
+	bounds := []struct {
+		name     string
+		min, max int
+	}{
+		{"minute", 0, 59},
+		{"hour", 0, 23},
+		{"day of month", 1, 31},
+		{"month", 1, 12},
+		{"day of week", 0, 7},
+	}
+
+	var sets [5]uint64
+	for i, field := range fields {
+		set, err := parseCronField(field, bounds[i].min, bounds[i].max)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cron expression %q %s", expr, bounds[i].name)
+		}
+		sets[i] = set
+	}
+
+	// Sunday is both 0 and 7.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	return &cronSpec{
+		minute:  sets[0],
+		hour:    sets[1],
+		dom:     sets[2],
+		month:   sets[3],
+		dow:     sets[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges, and steps
+// into a bit set.
+func parseCronField(field string, min, max int) (uint64, error) {
+	var set uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i != -1 {
+			rangePart = part[:i]
+
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, errors.Errorf("invalid step in %q", part)
+			}
+		}
+
+		start, end := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+
+			var err error
+			if start, err = parseCronValue(bounds[0], min, max); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(bounds[1], min, max); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, errors.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			value, err := parseCronValue(rangePart, min, max)
+			if err != nil {
+				return 0, err
+			}
+
+			start = value
+			if !strings.Contains(part, "/") {
+				end = value
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+// parseCronValue parses a value between min and max.
+func parseCronValue(s string, min, max int) (int, error) {
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid value %q", s)
+	}
+
+	if value < min || value > max {
+		return 0, errors.Errorf("value %d is not between %d and %d", value, min, max)
+	}
+
+	return value, nil
+}
+
+// matchesDay returns true if the spec matches t's day.
+func (s *cronSpec) matchesDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// next returns the first time after t the spec matches. ok is false if there
+// is no match within five years, e.g. for February 30th.
+func (s *cronSpec) next(t time.Time) (next time.Time, ok bool) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCronSchedule(t *testing.T) {
+	// Wednesday, 2020-09-02 10:07 UTC.
+	from := time.Date(2020, time.September, 2, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected []time.Time
+	}{
+		{
+			expr: "*/15 * * * *",
+			expected: []time.Time{
+				time.Date(2020, time.September, 2, 10, 15, 0, 0, time.UTC),
+				time.Date(2020, time.September, 2, 10, 30, 0, 0, time.UTC),
+				time.Date(2020, time.September, 2, 10, 45, 0, 0, time.UTC),
+			},
+		},
+		{
+			expr: "30 2 * * 1-5",
+			expected: []time.Time{
+				time.Date(2020, time.September, 3, 2, 30, 0, 0, time.UTC),
+				time.Date(2020, time.September, 4, 2, 30, 0, 0, time.UTC),
+				time.Date(2020, time.September, 7, 2, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			expr: "@monthly",
+			expected: []time.Time{
+				time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, time.November, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			expr: "0 0 13 * 5",
+			expected: []time.Time{
+				time.Date(2020, time.September, 4, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, time.September, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, time.September, 13, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			cs, err := NewCronSchedule(test.expr)
+			require.NoError(t, err)
+			require.Len(t, cs.Config.NextRuns, DefaultCronRuns)
+
+			cs.SetNextRuns(from, len(test.expected))
+
+			var expected []int64
+			for _, e := range test.expected {
+				expected = append(expected, e.Unix())
+			}
+			assert.Equal(t, expected, cs.Config.NextRuns)
+
+			data, err := json.Marshal(cs)
+			require.NoError(t, err)
+
+			var to TypedObject
+			require.NoError(t, json.Unmarshal(data, &to))
+
+			got, err := to.ToComponent()
+			require.NoError(t, err)
+
+			AssertEqual(t, cs, got)
+		})
+	}
+}
+
+func TestNewCronSchedule_invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "5-1 * * * *", "@often", "a * * * *"} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := NewCronSchedule(expr)
+			require.Error(t, err)
+		})
+	}
+}
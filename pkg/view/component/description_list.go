@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// DescriptionListItem is a term and its details.
+type DescriptionListItem struct {
+	Term    string    `json:"term"`
+	Details Component `json:"details"`
+}
+
+// UnmarshalJSON unmarshals a description list item from JSON.
+func (d *DescriptionListItem) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Term    string      `json:"term"`
+		Details TypedObject `json:"details"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	details, err := x.Details.ToComponent()
+	if err != nil {
+		return err
+	}
+
+	d.Term = x.Term
+	d.Details = details
+
+	return nil
+}
+
+// DescriptionListConfig is the contents of DescriptionList.
+type DescriptionListConfig struct {
+	Items []DescriptionListItem `json:"items"`
+}
+
+// DescriptionList is a component which renders terms and their details in
+// two columns. Items are rendered in the order they were added.
+//
+// +octant:component
+type DescriptionList struct {
+	Base
+	Config DescriptionListConfig `json:"config"`
+}
+
+var _ Container = (*DescriptionList)(nil)
+
+// NewDescriptionList creates a description list component.
+func NewDescriptionList() *DescriptionList {
+	return &DescriptionList{
+		Base: newBase(TypeDescriptionList, nil),
+	}
+}
+
+// Add adds a term and its details to the tail of the list.
+func (d *DescriptionList) Add(term string, details Component) {
+	d.Config.Items = append(d.Config.Items, DescriptionListItem{
+		Term:    term,
+		Details: details,
+	})
+}
+
+// IsEmpty returns true if the list has no items.
+func (d *DescriptionList) IsEmpty() bool {
+	return len(d.Config.Items) == 0
+}
+
+// Children returns the details of the list's items.
+func (d *DescriptionList) Children() []Component {
+	var children []Component
+	for _, item := range d.Config.Items {
+		children = append(children, item.Details)
+	}
+
+	return children
+}
+
+type descriptionListMarshal DescriptionList
+
+// MarshalJSON implements json.Marshaler
+func (d *DescriptionList) MarshalJSON() ([]byte, error) {
+	m := descriptionListMarshal(*d)
+	m.Metadata.Type = TypeDescriptionList
+	return json.Marshal(&m)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescriptionList_IsEmpty(t *testing.T) {
+	list := NewDescriptionList()
+	assert.True(t, list.IsEmpty())
+
+	list.Add("name", NewText("nginx"))
+	assert.False(t, list.IsEmpty())
+}
+
+func TestDescriptionList_Marshal(t *testing.T) {
+	list := NewDescriptionList()
+	list.Add("name", NewText("nginx"))
+	list.Add("owner", NewLink("", "deployment", "/deployment"))
+
+	data, err := json.Marshal(list)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, list, got)
+
+	items := got.(*DescriptionList).Config.Items
+	require.Len(t, items, 2)
+	assert.Equal(t, "name", items[0].Term)
+	assert.Equal(t, "owner", items[1].Term)
+
+	link, ok := items[1].Details.(*Link)
+	require.True(t, ok)
+	assert.Equal(t, "/deployment", link.Ref())
+}
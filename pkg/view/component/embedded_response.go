@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// EmbeddedResponseConfig is the contents of EmbeddedResponse.
+type EmbeddedResponseConfig struct {
+	Response *ContentResponse `json:"response"`
+}
+
+// EmbeddedResponse is a component which wraps a content response. It allows
+// views to be composed of other views.
+//
+// +octant:component
+type EmbeddedResponse struct {
+	Base
+	Config EmbeddedResponseConfig `json:"config"`
+}
+
+var _ Container = (*EmbeddedResponse)(nil)
+
+// NewEmbedded creates an embedded response component.
+func NewEmbedded(cr *ContentResponse) *EmbeddedResponse {
+	return &EmbeddedResponse{
+		Base: newBase(TypeEmbeddedResponse, nil),
+		Config: EmbeddedResponseConfig{
+			Response: cr,
+		},
+	}
+}
+
+// IsEmpty returns true if there is no response or the response has no components.
+func (e *EmbeddedResponse) IsEmpty() bool {
+	return e.Config.Response == nil || len(e.Config.Response.Components) == 0
+}
+
+// Children returns the title, components, and footer of the embedded
+// response.
+func (e *EmbeddedResponse) Children() []Component {
+	if e.Config.Response == nil {
+		return nil
+	}
+
+	var children []Component
+	for _, title := range e.Config.Response.Title {
+		children = append(children, title)
+	}
+	children = append(children, e.Config.Response.Components...)
+	children = append(children, e.Config.Response.Footer...)
+	return children
+}
+
+type embeddedResponseMarshal EmbeddedResponse
+
+// MarshalJSON implements json.Marshaler
+func (e *EmbeddedResponse) MarshalJSON() ([]byte, error) {
+	m := embeddedResponseMarshal(*e)
+	m.Metadata.Type = TypeEmbeddedResponse
+	return json.Marshal(&m)
+}
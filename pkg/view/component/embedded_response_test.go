@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedResponse_Marshal(t *testing.T) {
+	table := NewTableWithRows("pods", "placeholder", NewTableCols("name"), []TableRow{
+		{"name": NewText("nginx")},
+	})
+
+	cr := NewContentResponse(TitleFromString("nested"))
+	cr.Add(table)
+	// Content responses don't unmarshal their button group.
+	cr.ButtonGroup = nil
+
+	embedded := NewEmbedded(cr)
+
+	data, err := json.Marshal(embedded)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, embedded, got)
+
+	response := got.(*EmbeddedResponse).Config.Response
+	require.Len(t, response.Components, 1)
+	gotTable, ok := response.Components[0].(*Table)
+	require.True(t, ok)
+	assert.Len(t, gotTable.Rows(), 1)
+}
+
+func TestEmbeddedResponse_IsEmpty(t *testing.T) {
+	assert.True(t, NewEmbedded(nil).IsEmpty())
+
+	cr := NewContentResponse(TitleFromString("nested"))
+	assert.True(t, NewEmbedded(cr).IsEmpty())
+
+	cr.Add(NewText("text"))
+	assert.False(t, NewEmbedded(cr).IsEmpty())
+}
+
+func TestEmbeddedResponse_Children(t *testing.T) {
+	assert.Nil(t, NewEmbedded(nil).Children())
+
+	link := NewLink("", "pods", "/pods")
+	cr := NewContentResponse(Title(link))
+	cr.Add(NewText("body"))
+	cr.SetFooter(NewTimestamp(time.Unix(1600000000, 0)))
+
+	embedded := NewEmbedded(cr)
+	assert.Equal(t, []Component{link, NewText("body"), NewTimestamp(time.Unix(1600000000, 0))}, embedded.Children())
+
+	assert.Equal(t, []Component{link}, FindByType(embedded, TypeLink))
+	assert.Len(t, FindByType(embedded, TypeTimestamp), 1)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// EnvVarRefKind is the kind of object an environment variable refers to.
+type EnvVarRefKind string
+
+const (
+	// EnvVarRefConfigMap refers to a key in a config map.
+	EnvVarRefConfigMap EnvVarRefKind = "configMap"
+	// EnvVarRefSecret refers to a key in a secret.
+	EnvVarRefSecret EnvVarRefKind = "secret"
+)
+
+// EnvVarRef refers to a key in a config map or secret.
+type EnvVarRef struct {
+	Kind EnvVarRefKind `json:"kind"`
+	Name string        `json:"name"`
+	Key  string        `json:"key"`
+}
+
+// EnvVar is an environment variable. It has either a literal value or a
+// reference to a config map or secret key.
+type EnvVar struct {
+	Name  string     `json:"name"`
+	Value string     `json:"value,omitempty"`
+	Ref   *EnvVarRef `json:"ref,omitempty"`
+}
+
+var envVarNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validate returns an error if the variable's name isn't a valid identifier
+// or its reference is incomplete.
+func (ev EnvVar) validate() error {
+	if !envVarNameRe.MatchString(ev.Name) {
+		return errors.Errorf("environment variable name %q is invalid", ev.Name)
+	}
+
+	if ev.Ref == nil {
+		return nil
+	}
+
+	if ev.Value != "" {
+		return errors.Errorf("environment variable %q has a value and a reference", ev.Name)
+	}
+
+	switch ev.Ref.Kind {
+	case EnvVarRefConfigMap, EnvVarRefSecret:
+	default:
+		return errors.Errorf("environment variable %q refers to unknown kind %q", ev.Name, ev.Ref.Kind)
+	}
+
+	if ev.Ref.Name == "" || ev.Ref.Key == "" {
+		return errors.Errorf("environment variable %q reference requires a name and key", ev.Name)
+	}
+
+	return nil
+}
+
+// EnvEditorConfig is the contents of EnvEditor.
+type EnvEditorConfig struct {
+	// Vars are the environment variables.
+	Vars []EnvVar `json:"vars"`
+	// Action is the action path the edited variables are submitted to.
+	Action string `json:"action,omitempty"`
+}
+
+// EnvEditor is a component for adding, removing, and editing environment
+// variables.
+//
+// +octant:component
+type EnvEditor struct {
+	Base
+	Config EnvEditorConfig `json:"config"`
+}
+
+var _ Component = (*EnvEditor)(nil)
+
+// NewEnvEditor creates an environment variable editor component. An error is
+// returned if a variable is invalid or names are repeated.
+func NewEnvEditor(vars []EnvVar) (*EnvEditor, error) {
+	seen := make(map[string]bool)
+	for _, ev := range vars {
+		if err := ev.validate(); err != nil {
+			return nil, err
+		}
+
+		if seen[ev.Name] {
+			return nil, errors.Errorf("environment variable %q is repeated", ev.Name)
+		}
+		seen[ev.Name] = true
+	}
+
+	return &EnvEditor{
+		Base: newBase(TypeEnvEditor, nil),
+		Config: EnvEditorConfig{
+			Vars: append([]EnvVar{}, vars...),
+		},
+	}, nil
+}
+
+// SetAction sets the action path the edited variables are submitted to.
+func (ee *EnvEditor) SetAction(actionPath string) {
+	ee.Config.Action = actionPath
+}
+
+// IsEmpty returns false. Variables can be added to an empty editor.
+func (ee *EnvEditor) IsEmpty() bool {
+	return false
+}
+
+type envEditorMarshal EnvEditor
+
+// MarshalJSON implements json.Marshaler
+func (ee *EnvEditor) MarshalJSON() ([]byte, error) {
+	m := envEditorMarshal(*ee)
+	m.Metadata.Type = TypeEnvEditor
+	return json.Marshal(&m)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnvEditor(t *testing.T) {
+	vars := []EnvVar{
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{Name: "DB_HOST", Ref: &EnvVarRef{Kind: EnvVarRefConfigMap, Name: "db", Key: "host"}},
+		{Name: "DB_PASSWORD", Ref: &EnvVarRef{Kind: EnvVarRefSecret, Name: "db", Key: "password"}},
+	}
+
+	ee, err := NewEnvEditor(vars)
+	require.NoError(t, err)
+	ee.SetAction("overview/updateEnv")
+
+	data, err := json.Marshal(ee)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	value, err := to.GetString("vars.0.value")
+	require.NoError(t, err)
+	assert.Equal(t, "debug", value)
+
+	_, err = to.GetString("vars.0.ref.kind")
+	require.Error(t, err)
+
+	kind, err := to.GetString("vars.2.ref.kind")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", kind)
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, ee, got)
+	assert.Equal(t, vars, got.(*EnvEditor).Config.Vars)
+}
+
+func TestNewEnvEditor_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		vars []EnvVar
+	}{
+		{name: "leading digit", vars: []EnvVar{{Name: "1PORT"}}},
+		{name: "dash", vars: []EnvVar{{Name: "LOG-LEVEL"}}},
+		{name: "repeated", vars: []EnvVar{{Name: "A"}, {Name: "A"}}},
+		{name: "value and ref", vars: []EnvVar{{Name: "A", Value: "x", Ref: &EnvVarRef{Kind: EnvVarRefSecret, Name: "s", Key: "k"}}}},
+		{name: "incomplete ref", vars: []EnvVar{{Name: "A", Ref: &EnvVarRef{Kind: EnvVarRefSecret, Name: "s"}}}},
+		{name: "unknown ref kind", vars: []EnvVar{{Name: "A", Ref: &EnvVarRef{Kind: "pod", Name: "s", Key: "k"}}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewEnvEditor(test.vars)
+			require.Error(t, err)
+		})
+	}
+}
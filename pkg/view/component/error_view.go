@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrorView creates a content response describing err. It contains a card
+// with an error alert and the chain of wrapped errors, and the stack trace
+// if err has one. Plugins can return it when they fail to generate content.
+func ErrorView(err error) *ContentResponse {
+	cr := NewContentResponse(TitleFromString("Error"))
+	if err == nil {
+		return cr
+	}
+
+	var items []Component
+	for _, message := range errorChain(err) {
+		items = append(items, NewText(message))
+	}
+
+	card := NewCard(TitleFromString("Error"))
+	card.SetAlert(NewAlert(AlertTypeError, err.Error()))
+	card.SetBody(NewList(TitleFromString("Error chain"), items))
+	cr.Add(card)
+
+	if hasStackTrace(err) {
+		stack := NewCodeBlock(fmt.Sprintf("%+v", err))
+		stack.SetTitleText("Stack trace")
+		cr.Add(stack)
+	}
+
+	return cr
+}
+
+// errorChain returns the messages of err and the errors it wraps, outermost
+// first. Each message excludes the message of the error it wraps. Errors
+// which only add context without a message, e.g. a stack trace, are skipped.
+func errorChain(err error) []string {
+	var messages []string
+	for err != nil {
+		next := errors.Unwrap(err)
+
+		message := err.Error()
+		if next != nil {
+			if message == next.Error() {
+				err = next
+				continue
+			}
+			message = strings.TrimSuffix(message, ": "+next.Error())
+		}
+
+		messages = append(messages, message)
+		err = next
+	}
+
+	return messages
+}
+
+// hasStackTrace returns true if err or an error it wraps has a stack trace.
+func hasStackTrace(err error) bool {
+	var st interface {
+		StackTrace() errors.StackTrace
+	}
+	return errors.As(err, &st)
+}
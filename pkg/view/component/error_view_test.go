@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorView(t *testing.T) {
+	cause := fmt.Errorf("connection refused")
+	err := errors.Wrap(fmt.Errorf("list pods: %w", cause), "load overview")
+
+	cr := ErrorView(err)
+	require.Len(t, cr.Components, 2)
+
+	card, ok := cr.Components[0].(*Card)
+	require.True(t, ok)
+	require.NotNil(t, card.Config.Alert)
+	assert.Equal(t, AlertTypeError, card.Config.Alert.Type)
+	assert.Equal(t, "load overview: list pods: connection refused", card.Config.Alert.Message)
+
+	list, ok := card.Config.Body.(*List)
+	require.True(t, ok)
+
+	var chain []string
+	for _, item := range list.Config.Items {
+		chain = append(chain, item.String())
+	}
+	assert.Equal(t, []string{"load overview", "list pods", "connection refused"}, chain)
+
+	stack, ok := cr.Components[1].(*Code)
+	require.True(t, ok)
+	assert.Contains(t, stack.Config.Code, "TestErrorView")
+}
+
+func TestErrorView_noStackTrace(t *testing.T) {
+	cr := ErrorView(fmt.Errorf("failed"))
+	require.Len(t, cr.Components, 1)
+}
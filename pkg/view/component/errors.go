@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"regexp"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidColor is returned when a color is not a hex color or a color name.
+	ErrInvalidColor = errors.New("invalid color")
+	// ErrColumnNotFound is returned when a table column does not exist.
+	ErrColumnNotFound = errors.New("column not found")
+	// ErrValueOutOfRange is returned when a value is outside of its allowed range.
+	ErrValueOutOfRange = errors.New("value out of range")
+	// ErrValueExceedsTotal is returned when a value is larger than its total.
+	// It is the same error as ErrValueOutOfRange.
+	ErrValueExceedsTotal = ErrValueOutOfRange
+	// ErrUnknownComponentType is returned when a component type is not known.
+	ErrUnknownComponentType = errors.New("unknown component type")
+	// ErrNotTitleComponent is returned by UnmarshalStrict when a component in
+	// a title isn't a title component.
+	ErrNotTitleComponent = errors.New("component in title isn't a title view component")
+)
+
+// DefaultMaxComponentBytes is the default largest component config, in
+// bytes, which will be converted to a component.
+const DefaultMaxComponentBytes = 16 << 20
+
+var maxComponentBytes int64 = DefaultMaxComponentBytes
+
+// SetMaxComponentBytes sets the largest component config, in bytes, which
+// will be converted to a component. Configs of nested components are checked
+// individually. A limit less than 1 restores DefaultMaxComponentBytes.
+func SetMaxComponentBytes(n int) {
+	if n < 1 {
+		n = DefaultMaxComponentBytes
+	}
+
+	atomic.StoreInt64(&maxComponentBytes, int64(n))
+}
+
+// MaxComponentBytes returns the largest component config, in bytes, which
+// will be converted to a component.
+func MaxComponentBytes() int {
+	return int(atomic.LoadInt64(&maxComponentBytes))
+}
+
+// ComponentTooLargeError is returned when a component config is larger than
+// MaxComponentBytes().
+type ComponentTooLargeError struct {
+	// Type is the type of the component.
+	Type string
+	// Size is the size of the component config in bytes.
+	Size int
+	// Max is the maximum size of a component config in bytes.
+	Max int
+}
+
+func (e *ComponentTooLargeError) Error() string {
+	return fmt.Sprintf("%s component config is %d bytes, which exceeds the limit of %d bytes",
+		e.Type, e.Size, e.Max)
+}
+
+var colorRe = regexp.MustCompile(`^(#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})|[a-zA-Z]+)$`)
+
+// validateColor returns ErrInvalidColor if color is not a hex color or a
+// color name.
+func validateColor(color string) error {
+	if !colorRe.MatchString(color) {
+		return errors.Wrapf(ErrInvalidColor, "color %q", color)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func() error
+		expected error
+	}{
+		{
+			name: "invalid color",
+			fn: func() error {
+				return NewSparkline("cpu", nil).SetColor("#12")
+			},
+			expected: ErrInvalidColor,
+		},
+		{
+			name: "column not found",
+			fn: func() error {
+				table := NewTable("table", "placeholder", NewTableCols("Name"))
+				return table.SetColumnFrozen("Age", true)
+			},
+			expected: ErrColumnNotFound,
+		},
+		{
+			name: "value above range",
+			fn: func() error {
+				return NewProgressBar("deploy").SetPercent(101)
+			},
+			expected: ErrValueOutOfRange,
+		},
+		{
+			name: "value exceeds total",
+			fn: func() error {
+				return NewProgressBar("deploy").SetPercent(101)
+			},
+			expected: ErrValueExceedsTotal,
+		},
+		{
+			name: "value below range",
+			fn: func() error {
+				return NewProgressBar("deploy").SetPercent(-1)
+			},
+			expected: ErrValueOutOfRange,
+		},
+		{
+			name: "unknown component type",
+			fn: func() error {
+				to := TypedObject{Metadata: Metadata{Type: "unknown"}}
+				_, err := to.ToComponent()
+				return err
+			},
+			expected: ErrUnknownComponentType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fn()
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.expected), "got %v", err)
+		})
+	}
+}
+
+func TestValidateColor(t *testing.T) {
+	for _, color := range []string{"green", "#fff", "#60b515", "#60b515ff"} {
+		assert.NoError(t, validateColor(color), color)
+	}
+
+	for _, color := range []string{"", "#12", "#gggggg", "rgb(0, 0, 0)"} {
+		assert.True(t, errors.Is(validateColor(color), ErrInvalidColor), color)
+	}
+}
+
+func TestMaxComponentBytes(t *testing.T) {
+	SetMaxComponentBytes(64)
+	defer SetMaxComponentBytes(0)
+	require.Equal(t, 64, MaxComponentBytes())
+
+	small, err := json.Marshal(NewText("small"))
+	require.NoError(t, err)
+
+	large, err := json.Marshal(NewText(strings.Repeat("x", 100)))
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(large, &to))
+
+	_, err = to.ToComponent()
+	var tooLarge *ComponentTooLargeError
+	require.True(t, errors.As(err, &tooLarge), "got %v", err)
+	assert.Equal(t, TypeText, tooLarge.Type)
+	assert.Equal(t, 64, tooLarge.Max)
+
+	var cr ContentResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"viewComponents":[`+string(small)+`]}`), &cr))
+
+	err = json.Unmarshal([]byte(`{"viewComponents":[`+string(small)+`,`+string(large)+`]}`), &cr)
+	require.True(t, errors.As(err, &tooLarge), "got %v", err)
+
+	nested, err := json.Marshal(NewList(nil, []Component{NewText(strings.Repeat("x", 100))}))
+	require.NoError(t, err)
+
+	cr = ContentResponse{}
+	err = json.Unmarshal([]byte(`{"viewComponents":[`+string(nested)+`]}`), &cr)
+	require.True(t, errors.As(err, &tooLarge), "got %v", err)
+
+	SetMaxComponentBytes(0)
+	assert.Equal(t, DefaultMaxComponentBytes, MaxComponentBytes())
+
+	_, err = to.ToComponent()
+	require.NoError(t, err)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// TimelineEvent is an event in an event timeline.
+type TimelineEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+}
+
+// EventTimelineConfig is the contents of EventTimeline.
+type EventTimelineConfig struct {
+	Events []TimelineEvent `json:"events"`
+}
+
+// EventTimeline is a component which renders events as a vertical timeline.
+//
+// +octant:component
+type EventTimeline struct {
+	Base
+	Config EventTimelineConfig `json:"config"`
+}
+
+var _ Component = (*EventTimeline)(nil)
+
+// NewEventTimeline creates an event timeline component.
+func NewEventTimeline(events ...TimelineEvent) *EventTimeline {
+	return &EventTimeline{
+		Base: newBase(TypeEventTimeline, nil),
+		Config: EventTimelineConfig{
+			Events: append([]TimelineEvent(nil), events...),
+		},
+	}
+}
+
+// Add adds events to the timeline.
+func (et *EventTimeline) Add(events ...TimelineEvent) {
+	et.Config.Events = append(et.Config.Events, events...)
+}
+
+// IsEmpty returns true if the timeline has no events.
+func (et *EventTimeline) IsEmpty() bool {
+	return len(et.Config.Events) == 0
+}
+
+type eventTimelineMarshal EventTimeline
+
+// MarshalJSON implements json.Marshaler. Events are sorted by timestamp.
+func (et *EventTimeline) MarshalJSON() ([]byte, error) {
+	m := eventTimelineMarshal(*et)
+	m.Metadata.Type = TypeEventTimeline
+
+	events := append([]TimelineEvent(nil), et.Config.Events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+	m.Config.Events = events
+
+	return json.Marshal(&m)
+}
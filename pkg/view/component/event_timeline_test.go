@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTimeline_Marshal(t *testing.T) {
+	now := time.Date(2020, 9, 3, 14, 0, 0, 0, time.UTC)
+
+	timeline := NewEventTimeline(
+		TimelineEvent{Timestamp: now.Add(2 * time.Minute), Type: "Normal", Reason: "Started", Message: "started"},
+		TimelineEvent{Timestamp: now, Type: "Normal", Reason: "Scheduled", Message: "scheduled"},
+		TimelineEvent{Timestamp: now.Add(time.Minute), Type: "Warning", Reason: "BackOff", Message: "back off"},
+	)
+
+	data, err := json.Marshal(timeline)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	var reasons []string
+	for _, event := range got.(*EventTimeline).Config.Events {
+		reasons = append(reasons, event.Reason)
+	}
+	assert.Equal(t, []string{"Scheduled", "BackOff", "Started"}, reasons)
+
+	AssertEqual(t, timeline, got)
+}
+
+func TestEventTimeline_IsEmpty(t *testing.T) {
+	timeline := NewEventTimeline()
+	assert.True(t, timeline.IsEmpty())
+
+	timeline.Add(TimelineEvent{Timestamp: time.Now(), Reason: "Scheduled"})
+	assert.False(t, timeline.IsEmpty())
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"sort"
+	"time"
+)
+
+// EventTypeWarning is the type of a Kubernetes warning event.
+const EventTypeWarning = "Warning"
+
+// Event is a Kubernetes event.
+type Event struct {
+	// Type is the type of event, e.g. Normal or Warning.
+	Type string
+	// Reason is the reason for the event.
+	Reason string
+	// Message is the event message.
+	Message string
+	// From is the component which reported the event.
+	From string
+	// Timestamp is when the event last occurred.
+	Timestamp time.Time
+}
+
+// NewEvents creates a table of events, sorted newest first. Warning events
+// have a warning row status.
+func NewEvents(events ...Event) *Table {
+	sorted := append([]Event(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.After(sorted[j].Timestamp)
+	})
+
+	table := NewTable("Events", "There are no events", NewTableCols("Type", "Reason", "Age", "From", "Message"))
+
+	for _, event := range sorted {
+		row := TableRow{
+			"Type":    NewText(event.Type),
+			"Reason":  NewText(event.Reason),
+			"Age":     NewTimestamp(event.Timestamp),
+			"From":    NewText(event.From),
+			"Message": NewText(event.Message),
+		}
+
+		if event.Type == EventTypeWarning {
+			row[TableRowStatusKey] = NewText(string(StatusWarning))
+		}
+
+		table.Add(row)
+	}
+
+	return table
+}
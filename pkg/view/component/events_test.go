@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvents(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	got := NewEvents(
+		Event{Type: "Normal", Reason: "Scheduled", Message: "Assigned pod", From: "default-scheduler", Timestamp: now.Add(-time.Minute)},
+		Event{Type: EventTypeWarning, Reason: "BackOff", Message: "Back-off restarting failed container", From: "kubelet", Timestamp: now},
+	)
+
+	expected := NewTableWithRows("Events", "There are no events", NewTableCols("Type", "Reason", "Age", "From", "Message"), []TableRow{
+		{
+			"Type":            NewText("Warning"),
+			"Reason":          NewText("BackOff"),
+			"Age":             NewTimestamp(now),
+			"From":            NewText("kubelet"),
+			"Message":         NewText("Back-off restarting failed container"),
+			TableRowStatusKey: NewText("warning"),
+		},
+		{
+			"Type":    NewText("Normal"),
+			"Reason":  NewText("Scheduled"),
+			"Age":     NewTimestamp(now.Add(-time.Minute)),
+			"From":    NewText("default-scheduler"),
+			"Message": NewText("Assigned pod"),
+		},
+	})
+
+	AssertEqual(t, expected, got)
+	require.False(t, got.IsEmpty())
+	require.True(t, NewEvents().IsEmpty())
+
+	data, err := json.Marshal(got)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	roundTrip, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, got, roundTrip)
+}
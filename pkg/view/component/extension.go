@@ -53,6 +53,16 @@ func (e *Extension) AddTab(tab ExtensionTab) {
 	e.Config.Tabs = append(e.Config.Tabs, tab)
 }
 
+// Children returns the extension's tabs.
+func (e *Extension) Children() []Component {
+	var children []Component
+	for _, tab := range e.Config.Tabs {
+		children = append(children, tab.Tab)
+	}
+
+	return children
+}
+
 type extensionMarshal Extension
 
 func (e *Extension) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Frame is a stack frame in a flame graph.
+type Frame struct {
+	// Name is the name of the frame, e.g. a function name.
+	Name string `json:"name"`
+	// Self is the time spent in the frame itself.
+	Self time.Duration `json:"selfNanos"`
+	// Total is the time spent in the frame and its children.
+	Total time.Duration `json:"totalNanos"`
+	// Children are the frames called by the frame.
+	Children []*Frame `json:"children,omitempty"`
+}
+
+// validate returns an error if the durations of the frame or its descendants
+// are inconsistent. path is the path of the frame's parent.
+func (f *Frame) validate(path []string) error {
+	if f == nil {
+		return errors.Errorf("frame in %q is nil", strings.Join(path, " > "))
+	}
+
+	path = append(path, f.Name)
+
+	if f.Self < 0 || f.Self > f.Total {
+		return errors.Errorf("frame %q: self %s is not between 0 and total %s",
+			strings.Join(path, " > "), f.Self, f.Total)
+	}
+
+	children := f.Self
+	for _, child := range f.Children {
+		if err := child.validate(path); err != nil {
+			return err
+		}
+		children += child.Total
+	}
+
+	if children > f.Total {
+		return errors.Errorf("frame %q: self and children %s exceed total %s",
+			strings.Join(path, " > "), children, f.Total)
+	}
+
+	return nil
+}
+
+// FlameGraphConfig is the contents of FlameGraph.
+type FlameGraphConfig struct {
+	// Root is the root frame.
+	Root *Frame `json:"root"`
+}
+
+// FlameGraph is a component showing a profile as a flame graph.
+//
+// +octant:component
+type FlameGraph struct {
+	Base
+	Config FlameGraphConfig `json:"config"`
+}
+
+var _ Component = (*FlameGraph)(nil)
+
+// NewFlameGraph creates a flame graph component. An error is returned if the
+// time spent in a frame and its children exceeds the frame's total.
+func NewFlameGraph(root *Frame) (*FlameGraph, error) {
+	if err := root.validate(nil); err != nil {
+		return nil, err
+	}
+
+	return &FlameGraph{
+		Base: newBase(TypeFlameGraph, nil),
+		Config: FlameGraphConfig{
+			Root: root,
+		},
+	}, nil
+}
+
+// IsEmpty returns true if no time was spent in the root frame.
+func (fg *FlameGraph) IsEmpty() bool {
+	return fg.Config.Root == nil || fg.Config.Root.Total == 0
+}
+
+type flameGraphMarshal FlameGraph
+
+// MarshalJSON implements json.Marshaler
+func (fg *FlameGraph) MarshalJSON() ([]byte, error) {
+	m := flameGraphMarshal(*fg)
+	m.Metadata.Type = TypeFlameGraph
+	return json.Marshal(&m)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFlameGraph(t *testing.T) {
+	root := &Frame{
+		Name:  "main",
+		Self:  10 * time.Millisecond,
+		Total: 100 * time.Millisecond,
+		Children: []*Frame{
+			{
+				Name:  "handle",
+				Self:  20 * time.Millisecond,
+				Total: 60 * time.Millisecond,
+				Children: []*Frame{
+					{Name: "decode", Self: 40 * time.Millisecond, Total: 40 * time.Millisecond},
+				},
+			},
+			{Name: "gc", Self: 30 * time.Millisecond, Total: 30 * time.Millisecond},
+		},
+	}
+
+	fg, err := NewFlameGraph(root)
+	require.NoError(t, err)
+	require.False(t, fg.IsEmpty())
+
+	data, err := json.Marshal(fg)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, fg, got)
+	assert.Equal(t, root, got.(*FlameGraph).Config.Root)
+}
+
+func TestNewFlameGraph_invalid(t *testing.T) {
+	root := &Frame{
+		Name:  "main",
+		Total: 50 * time.Millisecond,
+		Children: []*Frame{
+			{Name: "handle", Self: 40 * time.Millisecond, Total: 40 * time.Millisecond},
+			{Name: "gc", Self: 30 * time.Millisecond, Total: 30 * time.Millisecond},
+		},
+	}
+
+	_, err := NewFlameGraph(root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"main"`)
+
+	root = &Frame{
+		Name:  "main",
+		Total: 50 * time.Millisecond,
+		Children: []*Frame{
+			{Name: "handle", Self: 40 * time.Millisecond, Total: 20 * time.Millisecond},
+		},
+	}
+
+	_, err = NewFlameGraph(root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"main > handle"`)
+}
@@ -109,6 +109,18 @@ func (fl *FlexLayout) AddSections(sections ...FlexLayoutSection) {
 	fl.Config.Sections = append(fl.Config.Sections, sections...)
 }
 
+// Children returns the views in the flex layout's sections.
+func (fl *FlexLayout) Children() []Component {
+	var children []Component
+	for _, section := range fl.Config.Sections {
+		for _, item := range section {
+			children = append(children, item.View)
+		}
+	}
+
+	return children
+}
+
 type flexLayoutMarshal FlexLayout
 
 // MarshalJSON marshals the flex layout to JSON.
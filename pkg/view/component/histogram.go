@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// HistogramBucket is a histogram bucket. It counts the values less than or
+// equal to UpperBound and greater than the previous bucket's upper bound.
+type HistogramBucket struct {
+	UpperBound float64 `json:"upperBound"`
+	Count      int     `json:"count"`
+}
+
+// HistogramConfig is the contents of Histogram.
+type HistogramConfig struct {
+	// Label describes the values.
+	Label string `json:"label"`
+	// Buckets are the buckets in ascending order.
+	Buckets []HistogramBucket `json:"buckets"`
+}
+
+// Histogram is a component showing a distribution of values as a bar chart.
+//
+// +octant:component
+type Histogram struct {
+	Base
+	Config HistogramConfig `json:"config"`
+}
+
+var _ Component = (*Histogram)(nil)
+
+// NewHistogram creates a histogram component.
+func NewHistogram(label string) *Histogram {
+	return &Histogram{
+		Base: newBase(TypeHistogram, nil),
+		Config: HistogramConfig{
+			Label:   label,
+			Buckets: []HistogramBucket{},
+		},
+	}
+}
+
+// AddBucket adds a bucket. The upper bound must be greater than the upper
+// bound of the previous bucket.
+func (h *Histogram) AddBucket(upperBound float64, count int) error {
+	if n := len(h.Config.Buckets); n > 0 {
+		if previous := h.Config.Buckets[n-1].UpperBound; upperBound <= previous {
+			return errors.Errorf("bucket upper bound %v is not greater than %v", upperBound, previous)
+		}
+	}
+
+	h.Config.Buckets = append(h.Config.Buckets, HistogramBucket{
+		UpperBound: upperBound,
+		Count:      count,
+	})
+	return nil
+}
+
+// IsEmpty returns true if the histogram has no buckets.
+func (h *Histogram) IsEmpty() bool {
+	return len(h.Config.Buckets) == 0
+}
+
+type histogramMarshal Histogram
+
+// MarshalJSON implements json.Marshaler
+func (h *Histogram) MarshalJSON() ([]byte, error) {
+	m := histogramMarshal(*h)
+	m.Metadata.Type = TypeHistogram
+	return json.Marshal(&m)
+}
+
+// String returns the label of the histogram.
+func (h *Histogram) String() string {
+	return h.Config.Label
+}
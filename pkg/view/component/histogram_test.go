@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogram_AddBucket(t *testing.T) {
+	h := NewHistogram("latency")
+	require.NoError(t, h.AddBucket(0.1, 4))
+	require.Error(t, h.AddBucket(0.05, 1))
+	require.Error(t, h.AddBucket(0.1, 1))
+
+	assert.Equal(t, []HistogramBucket{{UpperBound: 0.1, Count: 4}}, h.Config.Buckets)
+}
+
+func TestHistogram_Marshal(t *testing.T) {
+	h := NewHistogram("latency")
+	require.NoError(t, h.AddBucket(0.1, 4))
+	require.NoError(t, h.AddBucket(0.5, 10))
+	require.NoError(t, h.AddBucket(1, 2))
+
+	data, err := json.Marshal(h)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "histogram"
+  },
+  "config": {
+    "label": "latency",
+    "buckets": [
+      {"upperBound": 0.1, "count": 4},
+      {"upperBound": 0.5, "count": 10},
+      {"upperBound": 1, "count": 2}
+    ]
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, h, got)
+}
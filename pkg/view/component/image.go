@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ImageConfig is the contents of Image.
+type ImageConfig struct {
+	// URL is the image source. It can be an http(s) URL, a relative URL, or a
+	// data URI.
+	URL string `json:"url"`
+	// Alt is the alternate text for the image.
+	Alt string `json:"alt"`
+	// Width is the width of the image in pixels.
+	Width int `json:"width,omitempty"`
+	// Height is the height of the image in pixels.
+	Height int `json:"height,omitempty"`
+}
+
+// Image is a component for displaying an image.
+//
+// +octant:component
+type Image struct {
+	Base
+	Config ImageConfig `json:"config"`
+}
+
+var _ Component = (*Image)(nil)
+
+// NewImage creates an image component. An error is returned if the URL
+// scheme is not http, https, or data.
+func NewImage(imageURL, alt string) (*Image, error) {
+	if err := validateImageURL(imageURL); err != nil {
+		return nil, err
+	}
+
+	return &Image{
+		Base: newBase(TypeImage, nil),
+		Config: ImageConfig{
+			URL: imageURL,
+			Alt: alt,
+		},
+	}, nil
+}
+
+func validateImageURL(imageURL string) error {
+	u, err := url.Parse(strings.TrimSpace(imageURL))
+	if err != nil {
+		return errors.Wrap(err, "parse image url")
+	}
+
+	switch u.Scheme {
+	case "", "http", "https", "data":
+		return nil
+	default:
+		return errors.Errorf("image url scheme %q is not allowed", u.Scheme)
+	}
+}
+
+// SetSize sets the dimensions of the image in pixels.
+func (i *Image) SetSize(width, height int) {
+	i.Config.Width = width
+	i.Config.Height = height
+}
+
+type imageMarshal Image
+
+// MarshalJSON implements json.Marshaler
+func (i *Image) MarshalJSON() ([]byte, error) {
+	m := imageMarshal(*i)
+	m.Metadata.Type = TypeImage
+	return json.Marshal(&m)
+}
+
+// String returns the alternate text of the image.
+func (i *Image) String() string {
+	return i.Config.Alt
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImage(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "https", url: "https://example.com/logo.png"},
+		{name: "relative", url: "/assets/logo.png"},
+		{name: "data uri", url: "data:image/png;base64,iVBORw0KGgo="},
+		{name: "javascript", url: "javascript:alert(1)", wantErr: true},
+		{name: "javascript mixed case", url: " JavaScript:alert(1)", wantErr: true},
+		{name: "file", url: "file:///etc/passwd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			image, err := NewImage(tt.url, "logo")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.url, image.Config.URL)
+		})
+	}
+}
+
+func TestImage_Marshal(t *testing.T) {
+	image, err := NewImage("https://example.com/logo.png", "logo")
+	require.NoError(t, err)
+	image.SetSize(64, 32)
+
+	data, err := json.Marshal(image)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "image"
+  },
+  "config": {
+    "url": "https://example.com/logo.png",
+    "alt": "logo",
+    "width": 64,
+    "height": 32
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, image, got)
+}
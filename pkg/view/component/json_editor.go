@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSONEditorConfig is the contents of JSONEditor.
+type JSONEditorConfig struct {
+	// Content is the JSON document.
+	Content string `json:"content"`
+}
+
+// JSONEditor is a component for viewing a JSON document.
+//
+// +octant:component
+type JSONEditor struct {
+	Base
+	Config JSONEditorConfig `json:"config"`
+}
+
+var _ Component = (*JSONEditor)(nil)
+
+// NewJSONEditor creates a JSON editor component. If content is valid JSON,
+// it is indented.
+func NewJSONEditor(content string) *JSONEditor {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(content), "", "  "); err == nil {
+		content = buf.String()
+	}
+
+	return &JSONEditor{
+		Base: newBase(TypeJSONEditor, nil),
+		Config: JSONEditorConfig{
+			Content: content,
+		},
+	}
+}
+
+type jsonEditorMarshal JSONEditor
+
+// MarshalJSON implements json.Marshaler
+func (je *JSONEditor) MarshalJSON() ([]byte, error) {
+	m := jsonEditorMarshal(*je)
+	m.Metadata.Type = TypeJSONEditor
+	return json.Marshal(&m)
+}
+
+// String returns the JSON document.
+func (je *JSONEditor) String() string {
+	return je.Config.Content
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// JSONPatchOpType is the type of a JSON patch operation.
+type JSONPatchOpType string
+
+const (
+	// JSONPatchAdd adds a value.
+	JSONPatchAdd JSONPatchOpType = "add"
+	// JSONPatchRemove removes a value.
+	JSONPatchRemove JSONPatchOpType = "remove"
+	// JSONPatchReplace replaces a value.
+	JSONPatchReplace JSONPatchOpType = "replace"
+	// JSONPatchMove moves a value.
+	JSONPatchMove JSONPatchOpType = "move"
+	// JSONPatchCopy copies a value.
+	JSONPatchCopy JSONPatchOpType = "copy"
+	// JSONPatchTest tests a value.
+	JSONPatchTest JSONPatchOpType = "test"
+)
+
+// JSONPatchOp is a JSON patch (RFC 6902) operation.
+type JSONPatchOp struct {
+	Op    JSONPatchOpType `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value interface{}     `json:"value,omitempty"`
+}
+
+// validate returns an error if the operation is missing a field required by
+// its type.
+func (op JSONPatchOp) validate() error {
+	if !isJSONPointer(op.Path) {
+		return errors.Errorf("%s operation path %q is not a JSON pointer", op.Op, op.Path)
+	}
+
+	switch op.Op {
+	case JSONPatchAdd, JSONPatchReplace, JSONPatchTest:
+		if op.Value == nil {
+			return errors.Errorf("%s operation for %q requires a value", op.Op, op.Path)
+		}
+	case JSONPatchMove, JSONPatchCopy:
+		if op.From == "" || !isJSONPointer(op.From) {
+			return errors.Errorf("%s operation for %q requires a from JSON pointer", op.Op, op.Path)
+		}
+	case JSONPatchRemove:
+	default:
+		return errors.Errorf("unknown operation %q", op.Op)
+	}
+
+	return nil
+}
+
+// isJSONPointer returns true if s is a JSON pointer.
+func isJSONPointer(s string) bool {
+	return s == "" || strings.HasPrefix(s, "/")
+}
+
+// JSONPatchConfig is the contents of JSONPatch.
+type JSONPatchConfig struct {
+	// Operations are the operations in the order they are applied.
+	Operations []JSONPatchOp `json:"operations"`
+}
+
+// JSONPatch is a component showing the operations of a JSON patch. The UI
+// colors operations by type.
+//
+// +octant:component
+type JSONPatch struct {
+	Base
+	Config JSONPatchConfig `json:"config"`
+}
+
+var _ Component = (*JSONPatch)(nil)
+
+// NewJSONPatch creates a JSON patch component. An error is returned if an
+// operation is missing a field required by its type.
+func NewJSONPatch(ops ...JSONPatchOp) (*JSONPatch, error) {
+	for i, op := range ops {
+		if err := op.validate(); err != nil {
+			return nil, errors.Wrapf(err, "operation %d", i)
+		}
+	}
+
+	return &JSONPatch{
+		Base: newBase(TypeJSONPatch, nil),
+		Config: JSONPatchConfig{
+			Operations: append([]JSONPatchOp{}, ops...),
+		},
+	}, nil
+}
+
+// IsEmpty returns true if the patch has no operations.
+func (jp *JSONPatch) IsEmpty() bool {
+	return len(jp.Config.Operations) == 0
+}
+
+type jsonPatchMarshal JSONPatch
+
+// MarshalJSON implements json.Marshaler
+func (jp *JSONPatch) MarshalJSON() ([]byte, error) {
+	m := jsonPatchMarshal(*jp)
+	m.Metadata.Type = TypeJSONPatch
+	return json.Marshal(&m)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJSONPatch(t *testing.T) {
+	ops := []JSONPatchOp{
+		{Op: JSONPatchReplace, Path: "/spec/replicas", Value: 3},
+		{Op: JSONPatchAdd, Path: "/metadata/labels/tier", Value: "web"},
+		{Op: JSONPatchRemove, Path: "/metadata/annotations/deprecated"},
+		{Op: JSONPatchMove, From: "/spec/template/spec/nodeName", Path: "/spec/template/spec/nodeSelector"},
+	}
+
+	jp, err := NewJSONPatch(ops...)
+	require.NoError(t, err)
+	require.False(t, jp.IsEmpty())
+
+	data, err := json.Marshal(jp)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	op, err := to.GetString("operations.2.op")
+	require.NoError(t, err)
+	assert.Equal(t, "remove", op)
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, jp, got)
+}
+
+func TestNewJSONPatch_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		op   JSONPatchOp
+	}{
+		{name: "unknown op", op: JSONPatchOp{Op: "merge", Path: "/a"}},
+		{name: "add without value", op: JSONPatchOp{Op: JSONPatchAdd, Path: "/a"}},
+		{name: "copy without from", op: JSONPatchOp{Op: JSONPatchCopy, Path: "/a"}},
+		{name: "invalid path", op: JSONPatchOp{Op: JSONPatchRemove, Path: "a"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewJSONPatch(test.op)
+			require.Error(t, err)
+		})
+	}
+}
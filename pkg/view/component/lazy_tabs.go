@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// LazyTab is a tab whose contents are fetched when it is selected.
+type LazyTab struct {
+	// Name is the name of the tab.
+	Name string `json:"name"`
+	// ContentPath is the path the UI fetches the tab's contents from.
+	ContentPath string `json:"contentPath"`
+}
+
+// LazyTabsConfig is the contents of LazyTabs.
+type LazyTabsConfig struct {
+	// DefaultTab is the tab shown first. Its contents are included.
+	DefaultTab Tab `json:"defaultTab"`
+	// LazyTabs are the tabs whose contents are fetched when selected.
+	LazyTabs []LazyTab `json:"lazyTabs"`
+}
+
+// UnmarshalJSON unmarshals a lazy tabs config from JSON.
+func (c *LazyTabsConfig) UnmarshalJSON(data []byte) error {
+	x := struct {
+		DefaultTab struct {
+			Name     string      `json:"name"`
+			Contents TypedObject `json:"contents"`
+		} `json:"defaultTab"`
+		LazyTabs []LazyTab `json:"lazyTabs"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	component, err := x.DefaultTab.Contents.ToComponent()
+	if err != nil {
+		return err
+	}
+
+	contents, ok := component.(*FlexLayout)
+	if !ok {
+		return errors.New("default tab contents was not a flexlayout")
+	}
+
+	c.DefaultTab = Tab{
+		Name:     x.DefaultTab.Name,
+		Contents: *contents,
+	}
+	c.LazyTabs = x.LazyTabs
+
+	return nil
+}
+
+// LazyTabs is a component for tabs where only the default tab's contents are
+// included. The contents of other tabs are fetched when they are selected,
+// which reduces the size of the initial response.
+//
+// +octant:component
+type LazyTabs struct {
+	Base
+	Config LazyTabsConfig `json:"config"`
+}
+
+var _ Container = (*LazyTabs)(nil)
+
+// NewLazyTabs creates a lazy tabs component with a default tab.
+func NewLazyTabs(defaultTab Tab) *LazyTabs {
+	return &LazyTabs{
+		Base: newBase(TypeLazyTabs, nil),
+		Config: LazyTabsConfig{
+			DefaultTab: defaultTab,
+			LazyTabs:   []LazyTab{},
+		},
+	}
+}
+
+// AddLazyTab adds a tab whose contents are fetched from contentPath when it
+// is selected.
+func (lt *LazyTabs) AddLazyTab(name, contentPath string) {
+	lt.Config.LazyTabs = append(lt.Config.LazyTabs, LazyTab{
+		Name:        name,
+		ContentPath: contentPath,
+	})
+}
+
+// Children returns the contents of the default tab.
+func (lt *LazyTabs) Children() []Component {
+	return []Component{&lt.Config.DefaultTab.Contents}
+}
+
+type lazyTabsMarshal LazyTabs
+
+// MarshalJSON implements json.Marshaler
+func (lt *LazyTabs) MarshalJSON() ([]byte, error) {
+	m := lazyTabsMarshal(*lt)
+	m.Metadata.Type = TypeLazyTabs
+	return json.Marshal(&m)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazyTabs(t *testing.T) {
+	summary := NewFlexLayout("Summary")
+	summary.AddSections(FlexLayoutSection{
+		{Width: WidthFull, View: NewText("nginx")},
+	})
+
+	lt := NewLazyTabs(*NewTabWithContents(*summary))
+	lt.AddLazyTab("YAML", "/overview/namespace/default/workloads/deployments/nginx/yaml")
+	lt.AddLazyTab("Logs", "/overview/namespace/default/workloads/deployments/nginx/logs")
+
+	data, err := json.Marshal(lt)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, lt, got)
+
+	gotTabs := got.(*LazyTabs)
+	assert.Equal(t, "Summary", gotTabs.Config.DefaultTab.Name)
+	assert.Equal(t, []Component{NewText("nginx")}, gotTabs.Config.DefaultTab.Contents.Children())
+
+	require.Len(t, gotTabs.Config.LazyTabs, 2)
+	assert.Equal(t, "YAML", gotTabs.Config.LazyTabs[0].Name)
+	assert.NotEmpty(t, gotTabs.Config.LazyTabs[0].ContentPath)
+
+	// Lazy tabs only carry a content path.
+	contents, err := to.GetString("lazyTabs.1.contentPath")
+	require.NoError(t, err)
+	assert.Equal(t, "/overview/namespace/default/workloads/deployments/nginx/logs", contents)
+}
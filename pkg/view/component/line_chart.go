@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Point is a point in a chart.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// LineChartSeries is a named series of points.
+type LineChartSeries struct {
+	Name   string  `json:"name"`
+	Points []Point `json:"points"`
+}
+
+// LineChartConfig is the contents of LineChart.
+type LineChartConfig struct {
+	// XLabel is the label of the x axis.
+	XLabel string `json:"xLabel,omitempty"`
+	// YLabel is the label of the y axis.
+	YLabel string `json:"yLabel,omitempty"`
+	// Series are the series in the order they were added.
+	Series []LineChartSeries `json:"series"`
+}
+
+// LineChart is a component showing one or more series of points as lines,
+// e.g. metrics over time.
+//
+// +octant:component
+type LineChart struct {
+	Base
+	Config LineChartConfig `json:"config"`
+}
+
+var _ Component = (*LineChart)(nil)
+
+// NewLineChart creates a line chart component.
+func NewLineChart(title string) *LineChart {
+	return &LineChart{
+		Base: newBase(TypeLineChart, TitleFromString(title)),
+		Config: LineChartConfig{
+			Series: []LineChartSeries{},
+		},
+	}
+}
+
+// SetAxisLabels sets the labels of the axes.
+func (lc *LineChart) SetAxisLabels(x, y string) {
+	lc.Config.XLabel = x
+	lc.Config.YLabel = y
+}
+
+// AddSeries adds a series. A series must have at least one point, and its
+// name must be unique within the chart.
+func (lc *LineChart) AddSeries(name string, points []Point) error {
+	if len(points) == 0 {
+		return errors.Errorf("series %q has no points", name)
+	}
+
+	for _, series := range lc.Config.Series {
+		if series.Name == name {
+			return errors.Errorf("series %q already exists", name)
+		}
+	}
+
+	lc.Config.Series = append(lc.Config.Series, LineChartSeries{
+		Name:   name,
+		Points: append([]Point(nil), points...),
+	})
+
+	return nil
+}
+
+// IsEmpty returns true if the chart has no series.
+func (lc *LineChart) IsEmpty() bool {
+	return len(lc.Config.Series) == 0
+}
+
+type lineChartMarshal LineChart
+
+// MarshalJSON implements json.Marshaler
+func (lc *LineChart) MarshalJSON() ([]byte, error) {
+	m := lineChartMarshal(*lc)
+	m.Metadata.Type = TypeLineChart
+	return json.Marshal(&m)
+}
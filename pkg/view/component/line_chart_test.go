@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineChart(t *testing.T) {
+	lc := NewLineChart("CPU usage")
+	lc.SetAxisLabels("time", "millicores")
+	require.True(t, lc.IsEmpty())
+
+	require.NoError(t, lc.AddSeries("nginx", []Point{{X: 0, Y: 120}, {X: 60, Y: 180}, {X: 120, Y: 150}}))
+	require.NoError(t, lc.AddSeries("redis", []Point{{X: 0, Y: 40}, {X: 60, Y: 35.5}}))
+	require.Error(t, lc.AddSeries("empty", nil))
+	require.Error(t, lc.AddSeries("nginx", []Point{{X: 0, Y: 1}}))
+
+	data, err := json.Marshal(lc)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, lc, got)
+
+	series := got.(*LineChart).Config.Series
+	require.Len(t, series, 2)
+	assert.Equal(t, "nginx", series[0].Name)
+	assert.Equal(t, "redis", series[1].Name)
+	assert.Equal(t, Point{X: 60, Y: 35.5}, series[1].Points[1])
+}
@@ -57,6 +57,11 @@ func (t *List) Add(items ...Component) {
 	t.Config.Items = append(t.Config.Items, items...)
 }
 
+// Children returns the items in the list.
+func (t *List) Children() []Component {
+	return t.Config.Items
+}
+
 type listMarshal List
 
 // MarshalJSON implements json.Marshaler
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LiveCounterConfig is the contents of LiveCounter.
+type LiveCounterConfig struct {
+	// Label is the label for the counter.
+	Label string `json:"label"`
+	// SourceKey is the key of the data source the UI polls for the value.
+	SourceKey string `json:"sourceKey"`
+	// Value is the initial value of the counter.
+	Value int `json:"value"`
+	// PollIntervalMillis is how often the UI polls the data source. If it is
+	// not set, the UI subscribes to the data source instead.
+	PollIntervalMillis int64 `json:"pollIntervalMillis,omitempty"`
+}
+
+// LiveCounter is a component for a counter which the UI keeps up to date
+// using a data source.
+//
+// +octant:component
+type LiveCounter struct {
+	Base
+	Config LiveCounterConfig `json:"config"`
+}
+
+var _ Component = (*LiveCounter)(nil)
+
+// NewLiveCounter creates a live counter component.
+func NewLiveCounter(label, sourceKey string, initial int) *LiveCounter {
+	return &LiveCounter{
+		Base: newBase(TypeLiveCounter, nil),
+		Config: LiveCounterConfig{
+			Label:     label,
+			SourceKey: sourceKey,
+			Value:     initial,
+		},
+	}
+}
+
+// SetPollInterval sets how often the UI polls the data source. The interval
+// is truncated to milliseconds and must be positive.
+func (lc *LiveCounter) SetPollInterval(d time.Duration) error {
+	millis := int64(d / time.Millisecond)
+	if millis <= 0 {
+		return errors.Errorf("poll interval %s must be at least 1ms", d)
+	}
+
+	lc.Config.PollIntervalMillis = millis
+	return nil
+}
+
+// IsEmpty returns false. A counter always has a value.
+func (lc *LiveCounter) IsEmpty() bool {
+	return false
+}
+
+// String returns the label of the counter.
+func (lc *LiveCounter) String() string {
+	return lc.Config.Label
+}
+
+type liveCounterMarshal LiveCounter
+
+// MarshalJSON implements json.Marshaler
+func (lc *LiveCounter) MarshalJSON() ([]byte, error) {
+	m := liveCounterMarshal(*lc)
+	m.Metadata.Type = TypeLiveCounter
+	return json.Marshal(&m)
+}
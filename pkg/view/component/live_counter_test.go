@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLiveCounter(t *testing.T) {
+	lc := NewLiveCounter("Running pods", "pods/running", 3)
+	require.NoError(t, lc.SetPollInterval(5*time.Second))
+
+	require.Error(t, lc.SetPollInterval(0))
+	require.Error(t, lc.SetPollInterval(time.Microsecond))
+	assert.Equal(t, int64(5000), lc.Config.PollIntervalMillis)
+
+	data, err := json.Marshal(lc)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	sourceKey, err := to.GetString("sourceKey")
+	require.NoError(t, err)
+	assert.Equal(t, "pods/running", sourceKey)
+
+	interval, err := to.GetInt("pollIntervalMillis")
+	require.NoError(t, err)
+	assert.Equal(t, 5000, interval)
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, lc, got)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// MaskedString is the value returned by Masked.String.
+const MaskedString = "****"
+
+// MaskedConfig is the contents of Masked.
+type MaskedConfig struct {
+	// Value is the masked value. It is encoded using Encoding if set.
+	Value string `json:"value"`
+	// Encoding is the encoding of value.
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// Masked is a component for a sensitive value. The value is hidden until it
+// is revealed and can be copied.
+//
+// +octant:component
+type Masked struct {
+	Base
+	Config MaskedConfig `json:"config"`
+}
+
+var _ Component = (*Masked)(nil)
+
+// MaskedBase64 encodes the value of a masked component as base64. Values
+// which are already base64 encoded are left as is.
+func MaskedBase64() func(*Masked) {
+	return func(m *Masked) {
+		if m.Config.Encoding == "base64" {
+			return
+		}
+
+		m.Config.Value = base64.StdEncoding.EncodeToString([]byte(m.Config.Value))
+		m.Config.Encoding = "base64"
+	}
+}
+
+// NewMasked creates a masked component.
+func NewMasked(value string, options ...func(*Masked)) *Masked {
+	m := &Masked{
+		Base: newBase(TypeMasked, nil),
+		Config: MaskedConfig{
+			Value: value,
+		},
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
+}
+
+type maskedMarshal Masked
+
+// MarshalJSON implements json.Marshaler
+func (m *Masked) MarshalJSON() ([]byte, error) {
+	x := maskedMarshal(*m)
+	x.Metadata.Type = TypeMasked
+	return json.Marshal(&x)
+}
+
+// String returns a masked form of the value so the value is not leaked
+// when the component is logged.
+func (m *Masked) String() string {
+	return MaskedString
+}
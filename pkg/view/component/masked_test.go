@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMasked(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []func(*Masked)
+		expected MaskedConfig
+	}{
+		{
+			name:     "plain",
+			expected: MaskedConfig{Value: "hunter2"},
+		},
+		{
+			name:     "base64",
+			options:  []func(*Masked){MaskedBase64()},
+			expected: MaskedConfig{Value: "aHVudGVyMg==", Encoding: "base64"},
+		},
+		{
+			name:     "base64 applied twice",
+			options:  []func(*Masked){MaskedBase64(), MaskedBase64()},
+			expected: MaskedConfig{Value: "aHVudGVyMg==", Encoding: "base64"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMasked("hunter2", tt.options...)
+			assert.Equal(t, tt.expected, m.Config)
+			assert.Equal(t, "****", m.String())
+		})
+	}
+}
+
+func TestMasked_Marshal(t *testing.T) {
+	m := NewMasked("hunter2", MaskedBase64())
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, m, got)
+	assert.Equal(t, "****", got.String())
+}
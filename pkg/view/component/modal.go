@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+)
+
+// ModalSize is the size of a modal.
+type ModalSize string
+
+const (
+	// ModalSizeSmall is a small modal.
+	ModalSizeSmall ModalSize = "sm"
+	// ModalSizeMedium is a medium modal. It is the default size.
+	ModalSizeMedium ModalSize = "md"
+	// ModalSizeLarge is a large modal.
+	ModalSizeLarge ModalSize = "lg"
+	// ModalSizeExtraLarge is an extra large modal.
+	ModalSizeExtraLarge ModalSize = "xl"
+)
+
+// ModalConfig is the contents of Modal.
+type ModalConfig struct {
+	// Body is the body of the modal.
+	Body Component `json:"body,omitempty"`
+	// Form is a form shown below the body. Its values are submitted with the
+	// confirm action.
+	Form *Form `json:"form,omitempty"`
+	// Opened is true if the modal is open.
+	Opened bool `json:"opened"`
+	// Size is the size of the modal.
+	Size ModalSize `json:"size,omitempty"`
+	// ConfirmAction is the action path invoked when the modal is confirmed.
+	ConfirmAction string `json:"confirmAction,omitempty"`
+	// CancelAction is the action path invoked when the modal is cancelled.
+	CancelAction string `json:"cancelAction,omitempty"`
+}
+
+// UnmarshalJSON unmarshals a modal config from JSON.
+func (m *ModalConfig) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Body          *TypedObject `json:"body,omitempty"`
+		Form          *Form        `json:"form,omitempty"`
+		Opened        bool         `json:"opened"`
+		Size          ModalSize    `json:"size,omitempty"`
+		ConfirmAction string       `json:"confirmAction,omitempty"`
+		CancelAction  string       `json:"cancelAction,omitempty"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	if x.Body != nil {
+		body, err := x.Body.ToComponent()
+		if err != nil {
+			return err
+		}
+		m.Body = body
+	}
+
+	m.Form = x.Form
+	m.Opened = x.Opened
+	m.Size = x.Size
+	m.ConfirmAction = x.ConfirmAction
+	m.CancelAction = x.CancelAction
+
+	return nil
+}
+
+// Modal is a component for a dialog shown over the page, e.g. to confirm a
+// destructive action.
+//
+// +octant:component
+type Modal struct {
+	Base
+	Config ModalConfig `json:"config"`
+}
+
+var _ Container = (*Modal)(nil)
+
+// NewModal creates a modal component.
+func NewModal(title string) *Modal {
+	return &Modal{
+		Base: newBase(TypeModal, TitleFromString(title)),
+		Config: ModalConfig{
+			Size: ModalSizeMedium,
+		},
+	}
+}
+
+// SetBody sets the body of the modal.
+func (m *Modal) SetBody(body Component) {
+	m.Config.Body = body
+}
+
+// SetForm sets the form of the modal.
+func (m *Modal) SetForm(form Form) {
+	m.Config.Form = &form
+}
+
+// SetSize sets the size of the modal.
+func (m *Modal) SetSize(size ModalSize) {
+	m.Config.Size = size
+}
+
+// SetActions sets the action paths invoked when the modal is confirmed or
+// cancelled.
+func (m *Modal) SetActions(confirm, cancel string) {
+	m.Config.ConfirmAction = confirm
+	m.Config.CancelAction = cancel
+}
+
+// Open opens the modal.
+func (m *Modal) Open() {
+	m.Config.Opened = true
+}
+
+// Close closes the modal.
+func (m *Modal) Close() {
+	m.Config.Opened = false
+}
+
+// Children returns the body of the modal.
+func (m *Modal) Children() []Component {
+	if m.Config.Body == nil {
+		return nil
+	}
+
+	return []Component{m.Config.Body}
+}
+
+type modalMarshal Modal
+
+// MarshalJSON implements json.Marshaler
+func (m *Modal) MarshalJSON() ([]byte, error) {
+	x := modalMarshal(*m)
+	x.Metadata.Type = TypeModal
+	return json.Marshal(&x)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModal(t *testing.T) {
+	modal := NewModal("Delete deployment")
+	modal.SetBody(NewText("Are you sure you want to delete nginx?"))
+	modal.SetForm(Form{
+		Fields: []FormField{
+			NewFormFieldText("Confirm name", "name", ""),
+			NewFormFieldHidden("kind", "Deployment"),
+		},
+	})
+	modal.SetSize(ModalSizeLarge)
+	modal.SetActions("overview/deleteObject", "overview/cancel")
+	modal.Open()
+
+	assert.Equal(t, []Component{NewText("Are you sure you want to delete nginx?")}, modal.Children())
+
+	data, err := json.Marshal(modal)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, modal, got)
+
+	gotModal, ok := got.(*Modal)
+	require.True(t, ok)
+	assert.True(t, gotModal.Config.Opened)
+	require.NotNil(t, gotModal.Config.Form)
+	assert.Len(t, gotModal.Config.Form.Fields, 2)
+}
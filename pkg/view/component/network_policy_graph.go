@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// NetworkConnection is an allowed connection from one node to another.
+type NetworkConnection struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Ports are the allowed ports, e.g. "TCP/80". If there are no ports,
+	// all ports are allowed.
+	Ports []string `json:"ports,omitempty"`
+}
+
+// NetworkPolicyGraphConfig is the contents of NetworkPolicyGraph.
+type NetworkPolicyGraphConfig struct {
+	// Nodes are the pods and namespaces in the graph, keyed by ID.
+	Nodes Nodes `json:"nodes"`
+	// Connections are the allowed connections in the order they were added.
+	Connections []NetworkConnection `json:"connections"`
+}
+
+// NetworkPolicyGraph is a component showing the connections network policies
+// allow between pods and namespaces as directed edges.
+//
+// +octant:component
+type NetworkPolicyGraph struct {
+	Base
+	Config NetworkPolicyGraphConfig `json:"config"`
+}
+
+var _ Component = (*NetworkPolicyGraph)(nil)
+
+// NewNetworkPolicyGraph creates a network policy graph component.
+func NewNetworkPolicyGraph() *NetworkPolicyGraph {
+	return &NetworkPolicyGraph{
+		Base: newBase(TypeNetworkPolicyGraph, nil),
+		Config: NetworkPolicyGraphConfig{
+			Nodes:       Nodes{},
+			Connections: []NetworkConnection{},
+		},
+	}
+}
+
+// AddNode adds a node to the graph. Adding a node with an existing ID
+// replaces it.
+func (g *NetworkPolicyGraph) AddNode(id string, node Node) {
+	g.Config.Nodes[id] = node
+}
+
+// AddConnection adds an allowed connection from one node to another. Both
+// nodes must exist.
+func (g *NetworkPolicyGraph) AddConnection(from, to string, ports []string) error {
+	for _, id := range []string{from, to} {
+		if _, ok := g.Config.Nodes[id]; !ok {
+			return errors.Errorf("node %q does not exist in graph", id)
+		}
+	}
+
+	g.Config.Connections = append(g.Config.Connections, NetworkConnection{
+		From:  from,
+		To:    to,
+		Ports: append([]string(nil), ports...),
+	})
+
+	return nil
+}
+
+// IsEmpty returns true if the graph has no nodes.
+func (g *NetworkPolicyGraph) IsEmpty() bool {
+	return len(g.Config.Nodes) == 0
+}
+
+type networkPolicyGraphMarshal NetworkPolicyGraph
+
+// MarshalJSON implements json.Marshaler
+func (g *NetworkPolicyGraph) MarshalJSON() ([]byte, error) {
+	m := networkPolicyGraphMarshal(*g)
+	m.Metadata.Type = TypeNetworkPolicyGraph
+	return json.Marshal(&m)
+}
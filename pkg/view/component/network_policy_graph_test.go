@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkPolicyGraph(t *testing.T) {
+	g := NewNetworkPolicyGraph()
+	require.True(t, g.IsEmpty())
+
+	g.AddNode("frontend", Node{Name: "frontend", APIVersion: "v1", Kind: "Pod", Status: NodeStatusOK})
+	g.AddNode("backend", Node{Name: "backend", APIVersion: "v1", Kind: "Pod", Status: NodeStatusOK})
+	g.AddNode("monitoring", Node{Name: "monitoring", APIVersion: "v1", Kind: "Namespace"})
+
+	require.NoError(t, g.AddConnection("frontend", "backend", []string{"TCP/8080"}))
+	require.NoError(t, g.AddConnection("monitoring", "backend", nil))
+	require.Error(t, g.AddConnection("frontend", "database", []string{"TCP/5432"}))
+	require.Error(t, g.AddConnection("internet", "frontend", nil))
+
+	data, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, g, got)
+
+	connections := got.(*NetworkPolicyGraph).Config.Connections
+	assert.Equal(t, []NetworkConnection{
+		{From: "frontend", To: "backend", Ports: []string{"TCP/8080"}},
+		{From: "monitoring", To: "backend"},
+	}, connections)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// NotificationConfig is the contents of Notification.
+type NotificationConfig struct {
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+	// Action is an optional follow-up action.
+	Action *Button `json:"action,omitempty"`
+}
+
+// Notification is a component which shows the result of an operation, such
+// as a form submission.
+//
+// +octant:component
+type Notification struct {
+	Base
+	Config NotificationConfig `json:"config"`
+}
+
+var _ Component = (*Notification)(nil)
+
+// NewNotification creates a notification component.
+func NewNotification(status Status, message string) *Notification {
+	return &Notification{
+		Base: newBase(TypeNotification, nil),
+		Config: NotificationConfig{
+			Status:  status,
+			Message: message,
+		},
+	}
+}
+
+// SetAction sets a follow-up action for the notification.
+func (n *Notification) SetAction(button Button) {
+	n.Config.Action = &button
+}
+
+type notificationMarshal Notification
+
+// MarshalJSON implements json.Marshaler
+func (n *Notification) MarshalJSON() ([]byte, error) {
+	m := notificationMarshal(*n)
+	m.Metadata.Type = TypeNotification
+	return json.Marshal(&m)
+}
+
+// String returns the notification's message.
+func (n *Notification) String() string {
+	return n.Config.Message
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+func TestNotification_Marshal(t *testing.T) {
+	withAction := NewNotification(StatusError, "unable to scale deployment")
+	withAction.SetAction(NewButton("Retry", action.Payload{"action": "deployment/scale"}))
+
+	tests := []struct {
+		name         string
+		notification *Notification
+	}{
+		{name: "ok", notification: NewNotification(StatusOK, "deployment scaled")},
+		{name: "warning", notification: NewNotification(StatusWarning, "deployment is scaling")},
+		{name: "error with action", notification: withAction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.notification)
+			require.NoError(t, err)
+
+			var to TypedObject
+			require.NoError(t, json.Unmarshal(data, &to))
+
+			got, err := to.ToComponent()
+			require.NoError(t, err)
+
+			AssertEqual(t, tt.notification, got)
+			require.Equal(t, tt.notification.Config.Status, got.(*Notification).Config.Status)
+		})
+	}
+}
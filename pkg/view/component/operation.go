@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// OperationConfig is the contents of Operation.
+type OperationConfig struct {
+	// Name is the name of the operation.
+	Name string `json:"name"`
+	// Status is the status of the operation.
+	Status string `json:"status"`
+	// Percent is the completion percentage between 0 and 100.
+	Percent float64 `json:"percent,omitempty"`
+	// StartTime is the time the operation started in seconds since epoch. The
+	// elapsed time is shown if it is set.
+	StartTime int64 `json:"startTime,omitempty"`
+	// CancelAction is the action sent to cancel the operation.
+	CancelAction string `json:"cancelAction,omitempty"`
+}
+
+// Operation is a component showing the status of a long running operation.
+//
+// +octant:component
+type Operation struct {
+	Base
+	Config OperationConfig `json:"config"`
+}
+
+var _ Component = (*Operation)(nil)
+
+// NewOperation creates an operation component.
+func NewOperation(name, status string) *Operation {
+	return &Operation{
+		Base: newBase(TypeOperation, nil),
+		Config: OperationConfig{
+			Name:   name,
+			Status: status,
+		},
+	}
+}
+
+// SetProgress sets the completion percentage. The percentage must be between
+// 0 and 100.
+func (o *Operation) SetProgress(percent float64) error {
+	if percent < 0 || percent > 100 {
+		return errors.Wrapf(ErrValueOutOfRange, "percent %v is not between 0 and 100", percent)
+	}
+
+	o.Config.Percent = percent
+	return nil
+}
+
+// SetStartTime sets the time the operation started.
+func (o *Operation) SetStartTime(t time.Time) {
+	o.Config.StartTime = t.Unix()
+}
+
+// SetCancelAction sets the action sent when the operation is canceled.
+func (o *Operation) SetCancelAction(path string) {
+	o.Config.CancelAction = path
+}
+
+// IsEmpty returns false. An operation is always shown.
+func (o *Operation) IsEmpty() bool {
+	return false
+}
+
+type operationMarshal Operation
+
+// MarshalJSON implements json.Marshaler
+func (o *Operation) MarshalJSON() ([]byte, error) {
+	m := operationMarshal(*o)
+	m.Metadata.Type = TypeOperation
+	return json.Marshal(&m)
+}
+
+// String returns the name and status of the operation.
+func (o *Operation) String() string {
+	return o.Config.Name + ": " + o.Config.Status
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation_SetProgress(t *testing.T) {
+	o := NewOperation("backup", "running")
+	require.NoError(t, o.SetProgress(40))
+	assert.True(t, errors.Is(o.SetProgress(-1), ErrValueOutOfRange))
+	assert.True(t, errors.Is(o.SetProgress(101), ErrValueOutOfRange))
+	assert.True(t, errors.Is(o.SetProgress(101), ErrValueExceedsTotal))
+	assert.Equal(t, float64(40), o.Config.Percent)
+	assert.False(t, NewOperation("", "").IsEmpty())
+}
+
+func TestOperation_Marshal(t *testing.T) {
+	o := NewOperation("backup", "running")
+	require.NoError(t, o.SetProgress(40))
+	o.SetStartTime(time.Unix(1600000000, 0))
+	o.SetCancelAction("backup/cancel")
+
+	data, err := json.Marshal(o)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "operation"
+  },
+  "config": {
+    "name": "backup",
+    "status": "running",
+    "percent": 40,
+    "startTime": 1600000000,
+    "cancelAction": "backup/cancel"
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, o, got)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// OwnerChainLink is an object in an owner chain.
+type OwnerChainLink struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Ref  string `json:"ref,omitempty"`
+}
+
+// OwnerChainConfig is the contents of OwnerChain.
+type OwnerChainConfig struct {
+	// Links are the objects in the chain, starting with the top owner.
+	Links []OwnerChainLink `json:"links"`
+}
+
+// OwnerChain is a component showing the chain of owners of an object, e.g.
+// a deployment, its replica set, and a pod.
+//
+// +octant:component
+type OwnerChain struct {
+	Base
+	Config OwnerChainConfig `json:"config"`
+}
+
+var _ Component = (*OwnerChain)(nil)
+
+// NewOwnerChain creates an owner chain component.
+func NewOwnerChain() *OwnerChain {
+	return &OwnerChain{
+		Base: newBase(TypeOwnerChain, nil),
+		Config: OwnerChainConfig{
+			Links: []OwnerChainLink{},
+		},
+	}
+}
+
+// Add adds an object to the end of the chain. It should be owned by the
+// previous object. If ref is blank, the object isn't a link.
+func (oc *OwnerChain) Add(kind, name, ref string) {
+	oc.Config.Links = append(oc.Config.Links, OwnerChainLink{
+		Kind: kind,
+		Name: name,
+		Ref:  ref,
+	})
+}
+
+// IsEmpty returns true if the chain has no objects.
+func (oc *OwnerChain) IsEmpty() bool {
+	return len(oc.Config.Links) == 0
+}
+
+type ownerChainMarshal OwnerChain
+
+// MarshalJSON implements json.Marshaler
+func (oc *OwnerChain) MarshalJSON() ([]byte, error) {
+	m := ownerChainMarshal(*oc)
+	m.Metadata.Type = TypeOwnerChain
+	return json.Marshal(&m)
+}
+
+// String returns the objects in the chain separated by arrows.
+func (oc *OwnerChain) String() string {
+	var parts []string
+	for _, link := range oc.Config.Links {
+		parts = append(parts, fmt.Sprintf("%s %s", link.Kind, link.Name))
+	}
+
+	return strings.Join(parts, " → ")
+}
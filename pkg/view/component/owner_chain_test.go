@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnerChain(t *testing.T) {
+	oc := NewOwnerChain()
+	require.True(t, oc.IsEmpty())
+
+	oc.Add("Deployment", "nginx", "/overview/namespace/default/workloads/deployments/nginx")
+	oc.Add("ReplicaSet", "nginx-5d59d67564", "/overview/namespace/default/workloads/replica-sets/nginx-5d59d67564")
+	oc.Add("Pod", "nginx-5d59d67564-x7k2p", "")
+	require.False(t, oc.IsEmpty())
+
+	assert.Equal(t, "Deployment nginx → ReplicaSet nginx-5d59d67564 → Pod nginx-5d59d67564-x7k2p", oc.String())
+
+	data, err := json.Marshal(oc)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, oc, got)
+	assert.Equal(t, oc.Config.Links, got.(*OwnerChain).Config.Links)
+}
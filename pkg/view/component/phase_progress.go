@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PhaseStatus is the status of a phase.
+type PhaseStatus string
+
+const (
+	// PhaseStatusPending is a phase which hasn't started.
+	PhaseStatusPending PhaseStatus = "pending"
+	// PhaseStatusRunning is a phase which is in progress.
+	PhaseStatusRunning PhaseStatus = "running"
+	// PhaseStatusDone is a phase which has completed.
+	PhaseStatusDone PhaseStatus = "done"
+	// PhaseStatusFailed is a phase which has failed.
+	PhaseStatusFailed PhaseStatus = "failed"
+)
+
+// Phase is a named phase of an operation.
+type Phase struct {
+	Name   string      `json:"name"`
+	Status PhaseStatus `json:"status"`
+}
+
+// PhaseProgressConfig is the contents of PhaseProgress.
+type PhaseProgressConfig struct {
+	// Phases are the phases in the order they run.
+	Phases []Phase `json:"phases"`
+}
+
+// PhaseProgress is a component showing the progress of an operation through
+// a series of phases.
+//
+// +octant:component
+type PhaseProgress struct {
+	Base
+	Config PhaseProgressConfig `json:"config"`
+}
+
+var _ Component = (*PhaseProgress)(nil)
+
+// NewPhaseProgress creates a phase progress component. Phase names must be
+// unique.
+func NewPhaseProgress(phases ...Phase) (*PhaseProgress, error) {
+	seen := map[string]bool{}
+	for _, phase := range phases {
+		if seen[phase.Name] {
+			return nil, errors.Errorf("phase %q is not unique", phase.Name)
+		}
+		seen[phase.Name] = true
+	}
+
+	return &PhaseProgress{
+		Base: newBase(TypePhaseProgress, nil),
+		Config: PhaseProgressConfig{
+			Phases: phases,
+		},
+	}, nil
+}
+
+type phaseProgressMarshal PhaseProgress
+
+// MarshalJSON implements json.Marshaler
+func (pp *PhaseProgress) MarshalJSON() ([]byte, error) {
+	m := phaseProgressMarshal(*pp)
+	m.Metadata.Type = TypePhaseProgress
+	return json.Marshal(&m)
+}
+
+// String returns the phases and their statuses.
+func (pp *PhaseProgress) String() string {
+	var parts []string
+	for _, phase := range pp.Config.Phases {
+		parts = append(parts, phase.Name+": "+string(phase.Status))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPhaseProgress_duplicate(t *testing.T) {
+	_, err := NewPhaseProgress(
+		Phase{Name: "pull", Status: PhaseStatusDone},
+		Phase{Name: "pull", Status: PhaseStatusRunning},
+	)
+	require.Error(t, err)
+}
+
+func TestPhaseProgress_Marshal(t *testing.T) {
+	pp, err := NewPhaseProgress(
+		Phase{Name: "pull", Status: PhaseStatusDone},
+		Phase{Name: "rollout", Status: PhaseStatusRunning},
+		Phase{Name: "verify", Status: PhaseStatusPending},
+	)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(pp)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "phaseProgress"
+  },
+  "config": {
+    "phases": [
+      {"name": "pull", "status": "done"},
+      {"name": "rollout", "status": "running"},
+      {"name": "verify", "status": "pending"}
+    ]
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, pp, got)
+}
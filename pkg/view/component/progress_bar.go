@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// ProgressBarConfig is the contents of ProgressBar.
+type ProgressBarConfig struct {
+	// Label describes the operation.
+	Label string `json:"label"`
+	// Percent is the completion percentage between 0 and 100.
+	Percent float64 `json:"percent"`
+	// Indeterminate is true if the completion of the operation is unknown.
+	Indeterminate bool `json:"indeterminate,omitempty"`
+}
+
+// ProgressBar is a component showing the progress of an operation.
+//
+// +octant:component
+type ProgressBar struct {
+	Base
+	Config ProgressBarConfig `json:"config"`
+}
+
+var _ Component = (*ProgressBar)(nil)
+
+// NewProgressBar creates a progress bar component.
+func NewProgressBar(label string) *ProgressBar {
+	return &ProgressBar{
+		Base: newBase(TypeProgressBar, nil),
+		Config: ProgressBarConfig{
+			Label: label,
+		},
+	}
+}
+
+// SetPercent sets the completion percentage. The percentage must be between
+// 0 and 100.
+func (pb *ProgressBar) SetPercent(percent float64) error {
+	if percent < 0 || percent > 100 {
+		return errors.Wrapf(ErrValueOutOfRange, "percent %v is not between 0 and 100", percent)
+	}
+
+	pb.Config.Percent = percent
+	return nil
+}
+
+// SetIndeterminate sets whether the progress bar is indeterminate.
+func (pb *ProgressBar) SetIndeterminate(indeterminate bool) {
+	pb.Config.Indeterminate = indeterminate
+}
+
+type progressBarMarshal ProgressBar
+
+// MarshalJSON implements json.Marshaler
+func (pb *ProgressBar) MarshalJSON() ([]byte, error) {
+	m := progressBarMarshal(*pb)
+	m.Metadata.Type = TypeProgressBar
+	return json.Marshal(&m)
+}
+
+// String returns the label of the progress bar.
+func (pb *ProgressBar) String() string {
+	return pb.Config.Label
+}
+
+// LessThan returns true if this component's percent is less than the argument supplied.
+func (pb *ProgressBar) LessThan(i interface{}) bool {
+	v, ok := i.(*ProgressBar)
+	if !ok {
+		return false
+	}
+
+	return pb.Config.Percent < v.Config.Percent
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressBar_SetPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		percent float64
+		wantErr bool
+	}{
+		{name: "zero", percent: 0},
+		{name: "in range", percent: 42.5},
+		{name: "complete", percent: 100},
+		{name: "negative", percent: -1, wantErr: true},
+		{name: "over 100", percent: 100.1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb := NewProgressBar("deploy")
+			err := pb.SetPercent(tt.percent)
+			if tt.wantErr {
+				require.True(t, errors.Is(err, ErrValueOutOfRange))
+				assert.Equal(t, float64(0), pb.Config.Percent)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.percent, pb.Config.Percent)
+		})
+	}
+}
+
+func TestProgressBar_Marshal(t *testing.T) {
+	pb := NewProgressBar("deploy")
+	require.NoError(t, pb.SetPercent(50))
+
+	data, err := json.Marshal(pb)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "progressBar"
+  },
+  "config": {
+    "label": "deploy",
+    "percent": 50
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	pb.SetIndeterminate(true)
+
+	data, err = json.Marshal(pb)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, pb, got)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// QuotaUsageEntry is the usage of a resource.
+type QuotaUsageEntry struct {
+	// Resource is the name of the resource.
+	Resource string `json:"resource"`
+	// Used is the amount of the resource used.
+	Used string `json:"used"`
+	// Hard is the limit for the resource.
+	Hard string `json:"hard"`
+	// Percent is the percentage of the limit which is used.
+	Percent float64 `json:"percent"`
+	// OverQuota is true if more than the limit is used.
+	OverQuota bool `json:"overQuota,omitempty"`
+}
+
+// QuotaUsageConfig is the contents of QuotaUsage.
+type QuotaUsageConfig struct {
+	// Entries are the resource usages sorted by resource.
+	Entries []QuotaUsageEntry `json:"entries"`
+}
+
+// QuotaUsage is a component showing resource usage against quota limits.
+//
+// +octant:component
+type QuotaUsage struct {
+	Base
+	Config QuotaUsageConfig `json:"config"`
+}
+
+var _ Component = (*QuotaUsage)(nil)
+
+// NewQuotaUsage creates a quota usage component.
+func NewQuotaUsage() *QuotaUsage {
+	return &QuotaUsage{
+		Base: newBase(TypeQuotaUsage, nil),
+		Config: QuotaUsageConfig{
+			Entries: []QuotaUsageEntry{},
+		},
+	}
+}
+
+// Add adds the usage of a resource. If hard is zero, any usage is over quota.
+func (qu *QuotaUsage) Add(name string, used, hard resource.Quantity) {
+	entry := QuotaUsageEntry{
+		Resource:  name,
+		Used:      used.String(),
+		Hard:      hard.String(),
+		OverQuota: used.Cmp(hard) > 0,
+	}
+
+	if !hard.IsZero() {
+		entry.Percent = float64(used.MilliValue()) / float64(hard.MilliValue()) * 100
+	}
+
+	qu.Config.Entries = append(qu.Config.Entries, entry)
+}
+
+type quotaUsageMarshal QuotaUsage
+
+// MarshalJSON implements json.Marshaler
+func (qu *QuotaUsage) MarshalJSON() ([]byte, error) {
+	m := quotaUsageMarshal(*qu)
+	m.Metadata.Type = TypeQuotaUsage
+
+	m.Config.Entries = append([]QuotaUsageEntry(nil), qu.Config.Entries...)
+	sort.SliceStable(m.Config.Entries, func(i, j int) bool {
+		return m.Config.Entries[i].Resource < m.Config.Entries[j].Resource
+	})
+
+	return json.Marshal(&m)
+}
+
+// String returns the resource usages.
+func (qu *QuotaUsage) String() string {
+	var parts []string
+	for _, entry := range qu.Config.Entries {
+		parts = append(parts, entry.Resource+": "+entry.Used+"/"+entry.Hard)
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestQuotaUsage_Marshal(t *testing.T) {
+	qu := NewQuotaUsage()
+	qu.Add("pods", resource.MustParse("12"), resource.MustParse("10"))
+	qu.Add("limits.memory", resource.MustParse("512Mi"), resource.MustParse("2Gi"))
+	qu.Add("limits.cpu", resource.MustParse("500m"), resource.MustParse("2"))
+
+	data, err := json.Marshal(qu)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "quotaUsage"
+  },
+  "config": {
+    "entries": [
+      {"resource": "limits.cpu", "used": "500m", "hard": "2", "percent": 25},
+      {"resource": "limits.memory", "used": "512Mi", "hard": "2Gi", "percent": 25},
+      {"resource": "pods", "used": "12", "hard": "10", "percent": 120, "overQuota": true}
+    ]
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	gotEntries := got.(*QuotaUsage).Config.Entries
+	require.Len(t, gotEntries, 3)
+	assert.Equal(t, "limits.cpu", gotEntries[0].Resource)
+	assert.True(t, gotEntries[2].OverQuota)
+}
+
+func TestQuotaUsage_Add_zeroHard(t *testing.T) {
+	qu := NewQuotaUsage()
+	qu.Add("services", resource.MustParse("1"), resource.MustParse("0"))
+	qu.Add("secrets", resource.MustParse("0"), resource.MustParse("0"))
+
+	assert.True(t, qu.Config.Entries[0].OverQuota)
+	assert.False(t, qu.Config.Entries[1].OverQuota)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RegionCount is the count for a region.
+type RegionCount struct {
+	Region string `json:"region"`
+	Count  int    `json:"count"`
+}
+
+// RegionDistributionConfig is the contents of RegionDistribution.
+type RegionDistributionConfig struct {
+	// Regions are the region counts sorted by region.
+	Regions []RegionCount `json:"regions"`
+}
+
+// RegionDistribution is a component showing how something is distributed
+// across regions.
+//
+// +octant:component
+type RegionDistribution struct {
+	Base
+	Config RegionDistributionConfig `json:"config"`
+}
+
+var _ Component = (*RegionDistribution)(nil)
+
+// NewRegionDistribution creates a region distribution component.
+func NewRegionDistribution() *RegionDistribution {
+	return &RegionDistribution{
+		Base: newBase(TypeRegionDistribution, nil),
+		Config: RegionDistributionConfig{
+			Regions: []RegionCount{},
+		},
+	}
+}
+
+// Add adds count to region. Counts can't be negative.
+func (rd *RegionDistribution) Add(region string, count int) error {
+	if count < 0 {
+		return errors.Errorf("count %d for region %q is negative", count, region)
+	}
+
+	for i := range rd.Config.Regions {
+		if rd.Config.Regions[i].Region == region {
+			rd.Config.Regions[i].Count += count
+			return nil
+		}
+	}
+
+	rd.Config.Regions = append(rd.Config.Regions, RegionCount{Region: region, Count: count})
+	return nil
+}
+
+type regionDistributionMarshal RegionDistribution
+
+// MarshalJSON implements json.Marshaler
+func (rd *RegionDistribution) MarshalJSON() ([]byte, error) {
+	m := regionDistributionMarshal(*rd)
+	m.Metadata.Type = TypeRegionDistribution
+
+	m.Config.Regions = append([]RegionCount(nil), rd.Config.Regions...)
+	sort.Slice(m.Config.Regions, func(i, j int) bool {
+		return m.Config.Regions[i].Region < m.Config.Regions[j].Region
+	})
+
+	return json.Marshal(&m)
+}
+
+// String returns the region counts.
+func (rd *RegionDistribution) String() string {
+	var parts []string
+	for _, rc := range rd.Config.Regions {
+		parts = append(parts, fmt.Sprintf("%s: %d", rc.Region, rc.Count))
+	}
+
+	return strings.Join(parts, ", ")
+}
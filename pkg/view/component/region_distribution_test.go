@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionDistribution_Add(t *testing.T) {
+	rd := NewRegionDistribution()
+	require.NoError(t, rd.Add("us-east-1", 2))
+	require.NoError(t, rd.Add("us-east-1", 3))
+	require.Error(t, rd.Add("eu-west-1", -1))
+
+	assert.Equal(t, []RegionCount{{Region: "us-east-1", Count: 5}}, rd.Config.Regions)
+}
+
+func TestRegionDistribution_Marshal(t *testing.T) {
+	rd := NewRegionDistribution()
+	require.NoError(t, rd.Add("us-west-2", 4))
+	require.NoError(t, rd.Add("eu-west-1", 2))
+	require.NoError(t, rd.Add("ap-south-1", 0))
+
+	data, err := json.Marshal(rd)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "regionDistribution"
+  },
+  "config": {
+    "regions": [
+      {"region": "ap-south-1", "count": 0},
+      {"region": "eu-west-1", "count": 2},
+      {"region": "us-west-2", "count": 4}
+    ]
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	expectedComponent := NewRegionDistribution()
+	require.NoError(t, expectedComponent.Add("ap-south-1", 0))
+	require.NoError(t, expectedComponent.Add("eu-west-1", 2))
+	require.NoError(t, expectedComponent.Add("us-west-2", 4))
+
+	AssertEqual(t, expectedComponent, got)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReleaseEntry is the release notes for a version.
+type ReleaseEntry struct {
+	// Version is the semantic version of the release.
+	Version string `json:"version"`
+	// Date is the date of the release.
+	Date time.Time `json:"date"`
+	// Body is the markdown release notes.
+	Body string `json:"body"`
+}
+
+// ReleaseNotesConfig is the contents of ReleaseNotes.
+type ReleaseNotesConfig struct {
+	Entries []ReleaseEntry `json:"entries"`
+}
+
+// ReleaseNotes is a component showing release notes, newest version first.
+//
+// +octant:component
+type ReleaseNotes struct {
+	Base
+	Config ReleaseNotesConfig `json:"config"`
+}
+
+var _ Component = (*ReleaseNotes)(nil)
+
+// NewReleaseNotes creates a release notes component.
+func NewReleaseNotes(entries ...ReleaseEntry) *ReleaseNotes {
+	return &ReleaseNotes{
+		Base: newBase(TypeReleaseNotes, nil),
+		Config: ReleaseNotesConfig{
+			Entries: append([]ReleaseEntry{}, entries...),
+		},
+	}
+}
+
+// IsEmpty returns true if there are no entries.
+func (rn *ReleaseNotes) IsEmpty() bool {
+	return len(rn.Config.Entries) == 0
+}
+
+type releaseNotesMarshal ReleaseNotes
+
+// MarshalJSON implements json.Marshaler. Entries are sorted by semantic
+// version, newest first. Entry bodies are sanitized like text.
+func (rn *ReleaseNotes) MarshalJSON() ([]byte, error) {
+	m := releaseNotesMarshal(*rn)
+	m.Metadata.Type = TypeReleaseNotes
+
+	entries := append([]ReleaseEntry{}, rn.Config.Entries...)
+	for i := range entries {
+		entries[i].Body = sanitizeText(entries[i].Body)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return compareSemver(entries[i].Version, entries[j].Version) > 0
+	})
+	m.Config.Entries = entries
+
+	return json.Marshal(&m)
+}
+
+// compareSemver compares semantic versions a and b, returning -1, 0, or 1.
+// A leading "v" is ignored and build metadata is not compared. Invalid
+// versions are less than valid versions.
+func compareSemver(a, b string) int {
+	va, okA := parseSemver(a)
+	vb, okB := parseSemver(b)
+
+	switch {
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	case !okA:
+		return -1
+	case !okB:
+		return 1
+	}
+
+	for i := 0; i < 3; i++ {
+		if va.numbers[i] != vb.numbers[i] {
+			if va.numbers[i] < vb.numbers[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return comparePrerelease(va.prerelease, vb.prerelease)
+}
+
+type semver struct {
+	numbers    [3]int
+	prerelease []string
+}
+
+func parseSemver(s string) (semver, bool) {
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(s, '+'); i != -1 {
+		s = s[:i]
+	}
+
+	var v semver
+	if i := strings.IndexByte(s, '-'); i != -1 {
+		v.prerelease = strings.Split(s[i+1:], ".")
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return semver{}, false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return semver{}, false
+		}
+		v.numbers[i] = n
+	}
+
+	return v, true
+}
+
+// comparePrerelease compares pre-release identifiers. A version without
+// pre-release identifiers is greater than one with them.
+func comparePrerelease(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a) && i < len(b); i++ {
+		na, errA := strconv.Atoi(a[i])
+		nb, errB := strconv.Atoi(b[i])
+
+		switch {
+		case errA == nil && errB == nil:
+			if na != nb {
+				if na < nb {
+					return -1
+				}
+				return 1
+			}
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		default:
+			if c := strings.Compare(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseNotes_Marshal(t *testing.T) {
+	rn := NewReleaseNotes(
+		ReleaseEntry{Version: "v0.9.1", Date: time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC), Body: "* Fixed a bug"},
+		ReleaseEntry{Version: "v0.10.0", Date: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC), Body: "# Features\n\x1b[31m* Added a feature"},
+	)
+
+	data, err := json.Marshal(rn)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	entries := got.(*ReleaseNotes).Config.Entries
+	require.Len(t, entries, 2)
+	assert.Equal(t, "v0.10.0", entries[0].Version)
+	assert.Equal(t, "# Features\n* Added a feature", entries[0].Body)
+	assert.Equal(t, "v0.9.1", entries[1].Version)
+	assert.True(t, rn.Config.Entries[1].Date.Equal(entries[0].Date))
+}
+
+func Test_compareSemver(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "v1.0.0", b: "1.0.0", expected: 0},
+		{a: "v1.10.0", b: "v1.9.0", expected: 1},
+		{a: "v1.0.0-rc.1", b: "v1.0.0", expected: -1},
+		{a: "v1.0.0-rc.2", b: "v1.0.0-rc.10", expected: -1},
+		{a: "v1.0.0-alpha", b: "v1.0.0-1", expected: 1},
+		{a: "v1.0.0+build.1", b: "v1.0.0", expected: 0},
+		{a: "latest", b: "v0.0.1", expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compareSemver(tt.a, tt.b))
+			assert.Equal(t, -tt.expected, compareSemver(tt.b, tt.a))
+		})
+	}
+}
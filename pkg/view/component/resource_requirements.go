@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ResourceUnlimited is shown for resources without a limit.
+const ResourceUnlimited = "unlimited"
+
+// ResourceRequirement is the request and limit for a resource.
+type ResourceRequirement struct {
+	// Resource is the name of the resource.
+	Resource string `json:"resource"`
+	// Request is the requested amount. It is empty if there is no request.
+	Request string `json:"request"`
+	// Limit is the limit. It is ResourceUnlimited if there is no limit.
+	Limit string `json:"limit"`
+	// RequestPercent is the request as a percentage of the node's allocatable
+	// amount.
+	RequestPercent *float64 `json:"requestPercent,omitempty"`
+	// LimitPercent is the limit as a percentage of the node's allocatable
+	// amount.
+	LimitPercent *float64 `json:"limitPercent,omitempty"`
+}
+
+// ResourceRequirementsConfig is the contents of ResourceRequirements.
+type ResourceRequirementsConfig struct {
+	// Resources are the requirements sorted by resource.
+	Resources []ResourceRequirement `json:"resources"`
+}
+
+// ResourceRequirements is a component showing a container's resource requests
+// and limits.
+//
+// +octant:component
+type ResourceRequirements struct {
+	Base
+	Config ResourceRequirementsConfig `json:"config"`
+
+	requests corev1.ResourceList
+	limits   corev1.ResourceList
+}
+
+var _ Component = (*ResourceRequirements)(nil)
+
+// NewResourceRequirements creates a resource requirements component.
+func NewResourceRequirements(requests, limits corev1.ResourceList) *ResourceRequirements {
+	names := map[corev1.ResourceName]bool{}
+	for name := range requests {
+		names[name] = true
+	}
+	for name := range limits {
+		names[name] = true
+	}
+
+	resources := []ResourceRequirement{}
+	for name := range names {
+		rr := ResourceRequirement{
+			Resource: string(name),
+			Limit:    ResourceUnlimited,
+		}
+
+		if request, ok := requests[name]; ok {
+			rr.Request = request.String()
+		}
+		if limit, ok := limits[name]; ok {
+			rr.Limit = limit.String()
+		}
+
+		resources = append(resources, rr)
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Resource < resources[j].Resource
+	})
+
+	return &ResourceRequirements{
+		Base: newBase(TypeResourceRequirements, nil),
+		Config: ResourceRequirementsConfig{
+			Resources: resources,
+		},
+		requests: requests,
+		limits:   limits,
+	}
+}
+
+// SetNodeAllocatable sets the percentage of the node's allocatable resources
+// for each request and limit. Resources which aren't allocatable are skipped.
+func (rr *ResourceRequirements) SetNodeAllocatable(allocatable corev1.ResourceList) {
+	for i := range rr.Config.Resources {
+		r := &rr.Config.Resources[i]
+		name := corev1.ResourceName(r.Resource)
+
+		r.RequestPercent = nil
+		r.LimitPercent = nil
+
+		total, ok := allocatable[name]
+		if !ok || total.IsZero() {
+			continue
+		}
+
+		if request, ok := rr.requests[name]; ok {
+			percent := float64(request.MilliValue()) / float64(total.MilliValue()) * 100
+			r.RequestPercent = &percent
+		}
+		if limit, ok := rr.limits[name]; ok {
+			percent := float64(limit.MilliValue()) / float64(total.MilliValue()) * 100
+			r.LimitPercent = &percent
+		}
+	}
+}
+
+type resourceRequirementsMarshal ResourceRequirements
+
+// MarshalJSON implements json.Marshaler
+func (rr *ResourceRequirements) MarshalJSON() ([]byte, error) {
+	m := resourceRequirementsMarshal(*rr)
+	m.Metadata.Type = TypeResourceRequirements
+	return json.Marshal(&m)
+}
+
+// String returns the requests and limits.
+func (rr *ResourceRequirements) String() string {
+	var parts []string
+	for _, r := range rr.Config.Resources {
+		parts = append(parts, r.Resource+": "+r.Request+"/"+r.Limit)
+	}
+
+	return strings.Join(parts, ", ")
+}
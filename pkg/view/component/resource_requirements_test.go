@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestResourceRequirements_Marshal(t *testing.T) {
+	rr := NewResourceRequirements(
+		corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("250m"),
+			corev1.ResourceMemory: resource.MustParse("64Mi"),
+		},
+		corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+	)
+	rr.SetNodeAllocatable(corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("1"),
+	})
+
+	data, err := json.Marshal(rr)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "resourceRequirements"
+  },
+  "config": {
+    "resources": [
+      {"resource": "cpu", "request": "250m", "limit": "unlimited", "requestPercent": 25},
+      {"resource": "memory", "request": "64Mi", "limit": "128Mi"}
+    ]
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	assert.Equal(t, rr.Config, got.(*ResourceRequirements).Config)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// RolloutStatusConfig is the contents of RolloutStatus.
+type RolloutStatusConfig struct {
+	Desired   int32 `json:"desired"`
+	Current   int32 `json:"current"`
+	Updated   int32 `json:"updated"`
+	Available int32 `json:"available"`
+	Ready     int32 `json:"ready"`
+	// Percent is the percentage of desired replicas which are updated and
+	// available.
+	Percent int  `json:"percent"`
+	Paused  bool `json:"paused,omitempty"`
+}
+
+// RolloutStatus is a component showing the progress of a rolling update.
+//
+// +octant:component
+type RolloutStatus struct {
+	Base
+	Config RolloutStatusConfig `json:"config"`
+}
+
+var _ Component = (*RolloutStatus)(nil)
+
+// NewRolloutStatus creates a rollout status component. Counts can't be
+// negative.
+func NewRolloutStatus(desired, current, updated, available, ready int32) (*RolloutStatus, error) {
+	counts := []struct {
+		name  string
+		value int32
+	}{
+		{"desired", desired},
+		{"current", current},
+		{"updated", updated},
+		{"available", available},
+		{"ready", ready},
+	}
+
+	for _, count := range counts {
+		if count.value < 0 {
+			return nil, errors.Errorf("%s replica count %d is negative", count.name, count.value)
+		}
+	}
+
+	return &RolloutStatus{
+		Base: newBase(TypeRolloutStatus, nil),
+		Config: RolloutStatusConfig{
+			Desired:   desired,
+			Current:   current,
+			Updated:   updated,
+			Available: available,
+			Ready:     ready,
+			Percent:   rolloutPercent(desired, updated, available),
+		},
+	}, nil
+}
+
+// rolloutPercent returns the percentage of desired replicas which are updated
+// and available. A rollout with no desired replicas is complete.
+func rolloutPercent(desired, updated, available int32) int {
+	if desired == 0 {
+		return 100
+	}
+
+	done := updated
+	if available < done {
+		done = available
+	}
+	if done > desired {
+		done = desired
+	}
+
+	return int(done) * 100 / int(desired)
+}
+
+// SetPaused sets whether the rollout is paused.
+func (rs *RolloutStatus) SetPaused(paused bool) {
+	rs.Config.Paused = paused
+}
+
+// IsComplete returns true if all desired replicas are updated and available.
+func (rs *RolloutStatus) IsComplete() bool {
+	return rs.Config.Percent == 100
+}
+
+type rolloutStatusMarshal RolloutStatus
+
+// MarshalJSON implements json.Marshaler
+func (rs *RolloutStatus) MarshalJSON() ([]byte, error) {
+	m := rolloutStatusMarshal(*rs)
+	m.Metadata.Type = TypeRolloutStatus
+	return json.Marshal(&m)
+}
+
+// String returns the number of updated and desired replicas.
+func (rs *RolloutStatus) String() string {
+	return fmt.Sprintf("%d/%d updated", rs.Config.Updated, rs.Config.Desired)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRolloutStatus(t *testing.T) {
+	rs, err := NewRolloutStatus(4, 5, 3, 2, 4)
+	require.NoError(t, err)
+	rs.SetPaused(true)
+
+	assert.Equal(t, 50, rs.Config.Percent)
+	assert.False(t, rs.IsComplete())
+	assert.Equal(t, "3/4 updated", rs.String())
+
+	data, err := json.Marshal(rs)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, rs, got)
+	assert.True(t, got.(*RolloutStatus).Config.Paused)
+
+	complete, err := NewRolloutStatus(3, 3, 3, 3, 3)
+	require.NoError(t, err)
+	assert.True(t, complete.IsComplete())
+
+	scaledDown, err := NewRolloutStatus(0, 0, 0, 0, 0)
+	require.NoError(t, err)
+	assert.True(t, scaledDown.IsComplete())
+}
+
+func TestNewRolloutStatus_negative(t *testing.T) {
+	_, err := NewRolloutStatus(3, 3, -1, 3, 3)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "updated")
+}
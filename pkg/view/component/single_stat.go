@@ -5,7 +5,11 @@
 
 package component
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
 
 type SingleStatValue struct {
 	Text  string `json:"text"`
@@ -15,6 +19,33 @@ type SingleStatValue struct {
 type SingleStatConfig struct {
 	Title string          `json:"title"`
 	Value SingleStatValue `json:"value"`
+	// Trend compares the value to a previous value.
+	Trend *SingleStatTrend `json:"trend,omitempty"`
+}
+
+// TrendDirection is the direction of a trend.
+type TrendDirection string
+
+const (
+	// TrendUp is an increasing trend.
+	TrendUp TrendDirection = "up"
+	// TrendDown is a decreasing trend.
+	TrendDown TrendDirection = "down"
+	// TrendFlat is an unchanged trend.
+	TrendFlat TrendDirection = "flat"
+)
+
+// SingleStatTrend is the change of a single stat from a previous value.
+type SingleStatTrend struct {
+	// Previous is the previous value.
+	Previous float64 `json:"previous"`
+	// Delta is the value minus the previous value.
+	Delta float64 `json:"delta"`
+	// PercentChange is the change as a percentage of the previous value. It
+	// isn't set if the previous value is 0.
+	PercentChange *float64 `json:"percentChange,omitempty"`
+	// Direction is the direction of the change.
+	Direction TrendDirection `json:"direction"`
 }
 
 // Single stat shows a single statistic.
@@ -40,6 +71,42 @@ func NewSingleStat(title, valueText, color string) *SingleStat {
 	}
 }
 
+// NewStatWithTrend creates a single stat which shows the change from previous.
+// The value must be a number, optionally followed by a percent sign, for the
+// trend to be set.
+func NewStatWithTrend(title, value string, previous float64) *SingleStat {
+	ss := NewSingleStat(title, value, "")
+
+	current, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), "%"), 64)
+	if err != nil {
+		return ss
+	}
+
+	trend := &SingleStatTrend{
+		Previous:  previous,
+		Delta:     current - previous,
+		Direction: TrendFlat,
+	}
+
+	switch {
+	case trend.Delta > 0:
+		trend.Direction = TrendUp
+	case trend.Delta < 0:
+		trend.Direction = TrendDown
+	}
+
+	if previous != 0 {
+		percent := trend.Delta / previous * 100
+		if previous < 0 {
+			percent = -percent
+		}
+		trend.PercentChange = &percent
+	}
+
+	ss.Config.Trend = trend
+	return ss
+}
+
 type singleStatMarshal SingleStat
 
 func (ss *SingleStat) MarshalJSON() ([]byte, error) {
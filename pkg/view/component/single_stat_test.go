@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStatWithTrend(t *testing.T) {
+	percent := func(f float64) *float64 {
+		return &f
+	}
+
+	tests := []struct {
+		name     string
+		value    string
+		previous float64
+		expected *SingleStatTrend
+	}{
+		{
+			name:     "up",
+			value:    "15",
+			previous: 10,
+			expected: &SingleStatTrend{Previous: 10, Delta: 5, PercentChange: percent(50), Direction: TrendUp},
+		},
+		{
+			name:     "down",
+			value:    "75%",
+			previous: 100,
+			expected: &SingleStatTrend{Previous: 100, Delta: -25, PercentChange: percent(-25), Direction: TrendDown},
+		},
+		{
+			name:     "zero previous",
+			value:    "3",
+			previous: 0,
+			expected: &SingleStatTrend{Previous: 0, Delta: 3, Direction: TrendUp},
+		},
+		{
+			name:     "unchanged",
+			value:    "3",
+			previous: 3,
+			expected: &SingleStatTrend{Previous: 3, Delta: 0, PercentChange: percent(0), Direction: TrendFlat},
+		},
+		{
+			name:     "not a number",
+			value:    "Running",
+			previous: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := NewStatWithTrend("Pods", tt.value, tt.previous)
+			assert.Equal(t, tt.value, ss.Config.Value.Text)
+			assert.Equal(t, tt.expected, ss.Config.Trend)
+		})
+	}
+}
+
+func TestSingleStat_Marshal_trend(t *testing.T) {
+	ss := NewStatWithTrend("Pods", "15", 10)
+
+	data, err := json.Marshal(ss)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "singleStat"
+  },
+  "config": {
+    "title": "Pods",
+    "value": {"text": "15", "color": ""},
+    "trend": {"previous": 10, "delta": 5, "percentChange": 50, "direction": "up"}
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+}
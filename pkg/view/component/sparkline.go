@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// SparklineConfig is the contents of Sparkline.
+type SparklineConfig struct {
+	Label string    `json:"label"`
+	Data  []float64 `json:"data"`
+	Color string    `json:"color,omitempty"`
+}
+
+// Sparkline is a component which renders a small inline trend chart.
+//
+// +octant:component
+type Sparkline struct {
+	Base
+	Config SparklineConfig `json:"config"`
+}
+
+var _ Component = (*Sparkline)(nil)
+
+// NewSparkline creates a sparkline component.
+func NewSparkline(label string, data []float64) *Sparkline {
+	return &Sparkline{
+		Base: newBase(TypeSparkline, nil),
+		Config: SparklineConfig{
+			Label: label,
+			Data:  data,
+		},
+	}
+}
+
+// SetColor sets the color of the sparkline. The color must be a hex color or
+// a color name.
+func (s *Sparkline) SetColor(color string) error {
+	if err := validateColor(color); err != nil {
+		return err
+	}
+
+	s.Config.Color = color
+	return nil
+}
+
+// IsEmpty returns true if the sparkline has no data points.
+func (s *Sparkline) IsEmpty() bool {
+	return len(s.Config.Data) == 0
+}
+
+type sparklineMarshal Sparkline
+
+// MarshalJSON implements json.Marshaler
+func (s *Sparkline) MarshalJSON() ([]byte, error) {
+	m := sparklineMarshal(*s)
+	m.Metadata.Type = TypeSparkline
+	return json.Marshal(&m)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparkline_Marshal(t *testing.T) {
+	sparkline := NewSparkline("cpu", []float64{1, 2.5, 3})
+	require.NoError(t, sparkline.SetColor("green"))
+
+	data, err := json.Marshal(sparkline)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "sparkline"
+  },
+  "config": {
+    "label": "cpu",
+    "data": [1, 2.5, 3],
+    "color": "green"
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, sparkline, got)
+}
+
+func TestSparkline_IsEmpty(t *testing.T) {
+	assert.True(t, NewSparkline("cpu", nil).IsEmpty())
+	assert.False(t, NewSparkline("cpu", []float64{1}).IsEmpty())
+}
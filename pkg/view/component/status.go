@@ -0,0 +1,19 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+// Status is the status of a component or a part of a component. The UI uses
+// the status to choose a color.
+type Status string
+
+const (
+	// StatusOK means the item is healthy.
+	StatusOK Status = "ok"
+	// StatusWarning means the item needs attention.
+	StatusWarning Status = "warning"
+	// StatusError means the item has failed.
+	StatusError Status = "error"
+)
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// YAMLNodeKind is the kind of a YAML node.
+type YAMLNodeKind string
+
+const (
+	// YAMLNodeMapping is a mapping. Its children are its values.
+	YAMLNodeMapping YAMLNodeKind = "mapping"
+	// YAMLNodeSequence is a sequence. Its children are its items.
+	YAMLNodeSequence YAMLNodeKind = "sequence"
+	// YAMLNodeScalar is a scalar value.
+	YAMLNodeScalar YAMLNodeKind = "scalar"
+)
+
+// YAMLNode is a node in a YAML document.
+type YAMLNode struct {
+	// Key is the key of the node if its parent is a mapping.
+	Key string `json:"key,omitempty"`
+	// Kind is the kind of node.
+	Kind YAMLNodeKind `json:"kind"`
+	// Value is the value of a scalar node.
+	Value string `json:"value,omitempty"`
+	// Children are the children of a mapping or sequence node.
+	Children []YAMLNode `json:"children,omitempty"`
+}
+
+// StructuredYAMLConfig is the contents of StructuredYAML.
+type StructuredYAMLConfig struct {
+	// Root is the root node of the document.
+	Root *YAMLNode `json:"root,omitempty"`
+}
+
+// StructuredYAML is a component showing a YAML document as a tree with
+// collapsible nodes.
+//
+// +octant:component
+type StructuredYAML struct {
+	Base
+	Config StructuredYAMLConfig `json:"config"`
+}
+
+var _ Component = (*StructuredYAML)(nil)
+
+// NewStructuredYAML creates a structured YAML component from a YAML document.
+// Mapping keys are kept in the order they appear in the document.
+func NewStructuredYAML(data string) (*StructuredYAML, error) {
+	sy := &StructuredYAML{
+		Base: newBase(TypeStructuredYAML, nil),
+	}
+
+	if strings.TrimSpace(data) == "" {
+		return sy, nil
+	}
+
+	var doc yamlValue
+	if err := yaml.Unmarshal([]byte(data), &doc); err != nil {
+		return nil, errors.Wrap(err, "parse yaml")
+	}
+
+	root := yamlNode("", doc.value)
+	sy.Config.Root = &root
+
+	return sy, nil
+}
+
+// yamlValue decodes a YAML value. Mappings are decoded as yaml.MapSlice to
+// keep their key order.
+type yamlValue struct {
+	value interface{}
+}
+
+func (v *yamlValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var mapping yaml.MapSlice
+	if err := unmarshal(&mapping); err == nil {
+		v.value = mapping
+		return nil
+	}
+
+	var sequence []yamlValue
+	if err := unmarshal(&sequence); err == nil {
+		v.value = sequence
+		return nil
+	}
+
+	return unmarshal(&v.value)
+}
+
+// yamlNode converts a decoded YAML value to a node.
+func yamlNode(key string, value interface{}) YAMLNode {
+	node := YAMLNode{Key: key}
+
+	switch t := value.(type) {
+	case yaml.MapSlice:
+		node.Kind = YAMLNodeMapping
+		for _, item := range t {
+			node.Children = append(node.Children, yamlNode(fmt.Sprint(item.Key), item.Value))
+		}
+	case []yamlValue:
+		node.Kind = YAMLNodeSequence
+		for _, item := range t {
+			node.Children = append(node.Children, yamlNode("", item.value))
+		}
+	case []interface{}:
+		node.Kind = YAMLNodeSequence
+		for _, item := range t {
+			node.Children = append(node.Children, yamlNode("", item))
+		}
+	case nil:
+		node.Kind = YAMLNodeScalar
+		node.Value = "null"
+	default:
+		node.Kind = YAMLNodeScalar
+		node.Value = fmt.Sprint(t)
+	}
+
+	return node
+}
+
+// IsEmpty returns true if the document is empty.
+func (sy *StructuredYAML) IsEmpty() bool {
+	return sy.Config.Root == nil
+}
+
+type structuredYAMLMarshal StructuredYAML
+
+// MarshalJSON implements json.Marshaler
+func (sy *StructuredYAML) MarshalJSON() ([]byte, error) {
+	m := structuredYAMLMarshal(*sy)
+	m.Metadata.Type = TypeStructuredYAML
+	return json.Marshal(&m)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStructuredYAML(t *testing.T) {
+	data := `
+metadata:
+  name: nginx
+  labels:
+    tier: web
+    app: nginx
+spec:
+  containers:
+  - name: nginx
+    ports:
+    - 80
+    - 443
+  replicas: 2
+`
+
+	sy, err := NewStructuredYAML(data)
+	require.NoError(t, err)
+
+	expected := &YAMLNode{
+		Kind: YAMLNodeMapping,
+		Children: []YAMLNode{
+			{
+				Key:  "metadata",
+				Kind: YAMLNodeMapping,
+				Children: []YAMLNode{
+					{Key: "name", Kind: YAMLNodeScalar, Value: "nginx"},
+					{
+						Key:  "labels",
+						Kind: YAMLNodeMapping,
+						Children: []YAMLNode{
+							{Key: "tier", Kind: YAMLNodeScalar, Value: "web"},
+							{Key: "app", Kind: YAMLNodeScalar, Value: "nginx"},
+						},
+					},
+				},
+			},
+			{
+				Key:  "spec",
+				Kind: YAMLNodeMapping,
+				Children: []YAMLNode{
+					{
+						Key:  "containers",
+						Kind: YAMLNodeSequence,
+						Children: []YAMLNode{
+							{
+								Kind: YAMLNodeMapping,
+								Children: []YAMLNode{
+									{Key: "name", Kind: YAMLNodeScalar, Value: "nginx"},
+									{
+										Key:  "ports",
+										Kind: YAMLNodeSequence,
+										Children: []YAMLNode{
+											{Kind: YAMLNodeScalar, Value: "80"},
+											{Kind: YAMLNodeScalar, Value: "443"},
+										},
+									},
+								},
+							},
+						},
+					},
+					{Key: "replicas", Kind: YAMLNodeScalar, Value: "2"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, sy.Config.Root)
+
+	payload, err := json.Marshal(sy)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(payload, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, sy, got)
+}
+
+func TestNewStructuredYAML_sequenceRoot(t *testing.T) {
+	sy, err := NewStructuredYAML("- b: 1\n  a: 2\n- c\n")
+	require.NoError(t, err)
+
+	expected := &YAMLNode{
+		Kind: YAMLNodeSequence,
+		Children: []YAMLNode{
+			{
+				Kind: YAMLNodeMapping,
+				Children: []YAMLNode{
+					{Key: "b", Kind: YAMLNodeScalar, Value: "1"},
+					{Key: "a", Kind: YAMLNodeScalar, Value: "2"},
+				},
+			},
+			{Kind: YAMLNodeScalar, Value: "c"},
+		},
+	}
+	assert.Equal(t, expected, sy.Config.Root)
+}
+
+func TestNewStructuredYAML_invalid(t *testing.T) {
+	_, err := NewStructuredYAML("key: [unclosed")
+	require.Error(t, err)
+
+	sy, err := NewStructuredYAML("")
+	require.NoError(t, err)
+	assert.True(t, sy.IsEmpty())
+}
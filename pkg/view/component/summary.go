@@ -128,6 +128,16 @@ func (t *Summary) Sections() []SummarySection {
 	return t.Config.Sections
 }
 
+// Children returns the content of the summary's sections.
+func (t *Summary) Children() []Component {
+	var children []Component
+	for _, section := range t.Config.Sections {
+		children = append(children, section.Content)
+	}
+
+	return children
+}
+
 type summaryMarshal Summary
 
 // MarshalJSON implements json.Marshaler
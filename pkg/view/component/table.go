@@ -6,17 +6,28 @@ SPDX-License-Identifier: Apache-2.0
 package component
 
 import (
+	"encoding/csv"
 	"encoding/json"
-	"fmt"
+	"io"
 	"sort"
 	"sync"
 
-	"github.com/davecgh/go-spew/spew"
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
+const (
+	// TableRowStatusKey is the key for the status of a table row.
+	TableRowStatusKey = "_status"
+	// TableSectionHeaderKey is the key for the title of a section header row.
+	// Section header rows span all columns and have no other cells.
+	TableSectionHeaderKey = "_sectionHeader"
+	// TableSelectedRowsKey is the key in a bulk action payload for the
+	// accessors of the selected rows. The UI sets it when the action is invoked.
+	TableSelectedRowsKey = "selectedRows"
+)
+
 // TableFilter describer a text filter for a table.
 type TableFilter struct {
 	Values   []string `json:"values"`
@@ -31,6 +42,8 @@ type TableConfig struct {
 	Loading      bool                   `json:"loading"`
 	Filters      map[string]TableFilter `json:"filters"`
 	ButtonGroup  *ButtonGroup           `json:"buttonGroup,omitempty"`
+	Selectable   bool                   `json:"selectable,omitempty"`
+	BulkActions  []GridAction           `json:"bulkActions,omitempty"`
 }
 
 func (t *TableConfig) UnmarshalJSON(data []byte) error {
@@ -41,6 +54,8 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 		Loading      bool                   `json:"loading"`
 		Filters      map[string]TableFilter `json:"filters"`
 		ButtonGroup  *TypedObject           `json:"buttonGroup,omitempty"`
+		Selectable   bool                   `json:"selectable,omitempty"`
+		BulkActions  []GridAction           `json:"bulkActions,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -65,6 +80,8 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 	t.EmptyContent = x.EmptyContent
 	t.Loading = x.Loading
 	t.Filters = x.Filters
+	t.Selectable = x.Selectable
+	t.BulkActions = x.BulkActions
 
 	return nil
 }
@@ -74,11 +91,19 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 type TableCol struct {
 	Name     string `json:"name"`
 	Accessor string `json:"accessor"`
+	// Frozen columns stay visible when the table is scrolled horizontally.
+	Frozen bool `json:"frozen,omitempty"`
 }
 
 // TableRow is a row in table. Each key->value represents a particular column in the row.
 type TableRow map[string]Component
 
+// IsSectionHeader returns true if the row is a section header.
+func (t TableRow) IsSectionHeader() bool {
+	_, ok := t[TableSectionHeaderKey]
+	return ok
+}
+
 func (t TableRow) AddAction(gridAction GridAction) {
 	ga, ok := t[GridActionKey].(*GridActions)
 	if !ok {
@@ -158,27 +183,48 @@ func NewTableCols(keys ...string) []TableCol {
 
 // IsEmpty returns true if there is one or more rows.
 func (t *Table) IsEmpty() bool {
-	return len(t.Config.Rows) == 0
+	for _, row := range t.Config.Rows {
+		if !row.IsSectionHeader() {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (t *Table) SetPlaceholder(placeholder string) {
 	t.Config.EmptyContent = placeholder
 }
 
+// Sort sorts the rows by the column name. Section header rows stay in place
+// and rows are sorted within their section.
 func (t *Table) Sort(name string, reverse bool) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	sort.SliceStable(t.Rows(), func(i, j int) bool {
-		a, ok := t.Config.Rows[i][name]
+	rows := t.Config.Rows
+	start := 0
+	for i := 0; i <= len(rows); i++ {
+		if i < len(rows) && !rows[i].IsSectionHeader() {
+			continue
+		}
+
+		sortRows(rows[start:i], name, reverse)
+		start = i + 1
+	}
+}
+
+// sortRows sorts rows by the column name. Rows without the column keep their
+// position relative to each other.
+func sortRows(rows []TableRow, name string, reverse bool) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, ok := rows[i][name]
 		if !ok {
-			spew.Dump(fmt.Sprintf("%s:%d/%d", name, i, j), t.Config.Rows)
 			return false
 		}
 
-		b, ok := t.Config.Rows[j][name]
+		b, ok := rows[j][name]
 		if !ok {
-			spew.Dump(fmt.Sprintf("%s:%d/%d", name, i, j), t.Config.Rows)
 			return false
 		}
 
@@ -199,6 +245,12 @@ func (t *Table) Add(rows ...TableRow) {
 	t.Config.Rows = append(t.Config.Rows, rows...)
 }
 
+// AddSectionHeader adds a section header row with title to the tail of the
+// table. Rows added after the header are in its section.
+func (t *Table) AddSectionHeader(title string) {
+	t.Add(TableRow{TableSectionHeaderKey: NewText(title)})
+}
+
 // AddColumn adds a column to the table.
 func (t *Table) AddColumn(name string) {
 	t.mu.Lock()
@@ -228,6 +280,178 @@ func (t *Table) AddButton(name string, payload action.Payload, buttonOptions ...
 	t.Config.ButtonGroup.AddButton(button)
 }
 
+// EnableSelection makes the table's rows selectable. The bulk actions are
+// shown when one or more rows are selected, and are submitted with the
+// accessors of the selected rows in their payload.
+func (t *Table) EnableSelection(bulkActions ...GridAction) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.Selectable = true
+	t.Config.BulkActions = append(t.Config.BulkActions, bulkActions...)
+}
+
+// SetCellLink sets the cell in row for column to a link with text and ref.
+// The column is identified by its accessor.
+func (t *Table) SetCellLink(row int, column, text, ref string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if row < 0 || row >= len(t.Config.Rows) {
+		return errors.Errorf("row %d is out of range", row)
+	}
+
+	if !t.hasColumn(column) {
+		return errors.Wrapf(ErrColumnNotFound, "column %q", column)
+	}
+
+	t.Config.Rows[row][column] = NewLink("", text, ref)
+	return nil
+}
+
+// SetColumnFrozen sets whether the column with name is frozen. Frozen columns
+// must be contiguous starting from the leftmost column.
+func (t *Table) SetColumnFrozen(name string, frozen bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	index := -1
+	for i, col := range t.Config.Columns {
+		if col.Name == name {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return errors.Wrapf(ErrColumnNotFound, "column %q", name)
+	}
+
+	columns := append([]TableCol(nil), t.Config.Columns...)
+	columns[index].Frozen = frozen
+
+	sawUnfrozen := false
+	for _, col := range columns {
+		if !col.Frozen {
+			sawUnfrozen = true
+			continue
+		}
+
+		if sawUnfrozen {
+			return errors.Errorf("frozen column %q is not contiguous from the left", col.Name)
+		}
+	}
+
+	t.Config.Columns = columns
+	return nil
+}
+
+// SetRowStatus sets the status of a row. The UI tints the row using the status.
+func (t *Table) SetRowStatus(row int, status Status) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if row < 0 || row >= len(t.Config.Rows) {
+		return errors.Errorf("row %d is out of range", row)
+	}
+
+	t.Config.Rows[row][TableRowStatusKey] = NewText(string(status))
+	return nil
+}
+
+func (t *Table) hasColumn(accessor string) bool {
+	for _, col := range t.Config.Columns {
+		if col.Accessor == accessor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToCSV writes the table to w as CSV. The first record contains the column
+// names. Cells are written using their String value. Section headers are not
+// written.
+func (t *Table) ToCSV(w io.Writer) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cw := csv.NewWriter(w)
+
+	var header []string
+	for _, col := range t.Config.Columns {
+		header = append(header, col.Name)
+	}
+
+	if err := cw.Write(header); err != nil {
+		return errors.Wrap(err, "write csv header")
+	}
+
+	for _, row := range t.Config.Rows {
+		if row.IsSectionHeader() {
+			continue
+		}
+
+		record := make([]string, len(t.Config.Columns))
+		for i, col := range t.Config.Columns {
+			if cell, ok := row[col.Accessor]; ok && cell != nil {
+				record[i] = cell.String()
+			}
+		}
+
+		if err := cw.Write(record); err != nil {
+			return errors.Wrap(err, "write csv record")
+		}
+	}
+
+	cw.Flush()
+	return errors.Wrap(cw.Error(), "flush csv")
+}
+
+// PaginateTable splits the rows of base into tables with at most pageSize
+// rows. Each page has the metadata and configuration of base. A table without
+// rows has a single empty page.
+func PaginateTable(base *Table, pageSize int) ([]*Table, error) {
+	if pageSize < 1 {
+		return nil, errors.Errorf("page size %d is less than 1", pageSize)
+	}
+
+	base.mu.Lock()
+	defer base.mu.Unlock()
+
+	var pages []*Table
+	for start := 0; start == 0 || start < len(base.Config.Rows); start += pageSize {
+		end := start + pageSize
+		if end > len(base.Config.Rows) {
+			end = len(base.Config.Rows)
+		}
+
+		page := &Table{
+			Base:   base.Base,
+			Config: base.Config,
+		}
+		page.Config.Rows = append([]TableRow(nil), base.Config.Rows[start:end]...)
+		page.Config.Columns = append([]TableCol(nil), base.Config.Columns...)
+		page.Config.Filters = make(map[string]TableFilter, len(base.Config.Filters))
+		for k, v := range base.Config.Filters {
+			page.Config.Filters[k] = v
+		}
+		page.Metadata.Title = append([]TitleComponent(nil), base.Metadata.Title...)
+		page.Config.BulkActions = append([]GridAction(nil), base.Config.BulkActions...)
+		if bg := base.Config.ButtonGroup; bg != nil {
+			page.Config.ButtonGroup = &ButtonGroup{
+				Base:   bg.Base,
+				Config: ButtonGroupConfig{Buttons: append([]Button(nil), bg.Config.Buttons...)},
+			}
+			page.Config.ButtonGroup.Metadata.Title = append([]TitleComponent(nil), bg.Metadata.Title...)
+		}
+
+		pages = append(pages, page)
+	}
+
+	return pages, nil
+}
+
 // Columns returns the table columns.
 func (t *Table) Columns() []TableCol {
 	return t.Config.Columns
@@ -238,6 +462,25 @@ func (t *Table) Rows() []TableRow {
 	return t.Config.Rows
 }
 
+// Children returns the cells of the table's rows. Cells are returned
+// row by row, sorted by accessor.
+func (t *Table) Children() []Component {
+	var children []Component
+	for _, row := range t.Config.Rows {
+		var keys []string
+		for k := range row {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			children = append(children, row[k])
+		}
+	}
+
+	return children
+}
+
 type tableMarshal Table
 
 // MarshalJSON implements json.Marshaler
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// TableFromStructs creates a table with a row for each struct in items, which
+// must be a slice of structs or struct pointers. Each column is read from the
+// field with an `octant:"column"` tag or, if no field has the tag, the field
+// named column. Fields which are components are used as cells. Other fields
+// are converted to text. An error wrapping ErrColumnNotFound is returned if a
+// column doesn't map to a field.
+func TableFromStructs(title string, items interface{}, columns []string) (*Table, error) {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, errors.Errorf("items is %T, not a slice", items)
+	}
+
+	elemType := v.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, errors.Errorf("items is %T, not a slice of structs", items)
+	}
+
+	fields := make([][]int, len(columns))
+	for i, column := range columns {
+		index, ok := structFieldIndex(elemType, column)
+		if !ok {
+			return nil, errors.Wrapf(ErrColumnNotFound, "column %q in %s", column, elemType)
+		}
+		fields[i] = index
+	}
+
+	table := NewTable(title, "", NewTableCols(columns...))
+
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i)
+		if item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				continue
+			}
+			item = item.Elem()
+		}
+
+		row := TableRow{}
+		for j, column := range columns {
+			row[column] = structFieldComponent(item.FieldByIndex(fields[j]))
+		}
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+// structFieldIndex returns the index of the exported field for column in t.
+func structFieldIndex(t reflect.Type, column string) ([]int, bool) {
+	var byName []int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if field.Tag.Get("octant") == column {
+			return field.Index, true
+		}
+
+		if field.Name == column {
+			byName = field.Index
+		}
+	}
+
+	return byName, byName != nil
+}
+
+// structFieldComponent converts a struct field to a table cell.
+func structFieldComponent(v reflect.Value) Component {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return NewText("")
+		}
+	}
+
+	if c, ok := v.Interface().(Component); ok {
+		return c
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return NewText(s.String())
+	}
+
+	return NewText(fmt.Sprint(v.Interface()))
+}
@@ -6,13 +6,18 @@ SPDX-License-Identifier: Apache-2.0
 package component
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
 func Test_TableCols(t *testing.T) {
@@ -204,3 +209,386 @@ func TestTable_AddFilter(t *testing.T) {
 
 	assert.Equal(t, expected, table.Config.Filters)
 }
+
+func TestTable_SetCellLink(t *testing.T) {
+	tests := []struct {
+		name    string
+		row     int
+		column  string
+		wantErr bool
+	}{
+		{name: "in general", row: 0, column: "name"},
+		{name: "unknown column", row: 0, column: "missing", wantErr: true},
+		{name: "row out of range", row: 1, column: "name", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := NewTableWithRows("table", "placeholder", NewTableCols("name"), []TableRow{
+				{"name": NewText("pod")},
+			})
+
+			err := table.SetCellLink(tt.row, tt.column, "pod", "/pod")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			link, ok := table.Rows()[tt.row][tt.column].(*Link)
+			require.True(t, ok)
+			assert.Equal(t, "pod", link.Text())
+			assert.Equal(t, "/pod", link.Ref())
+		})
+	}
+}
+
+func TestTable_SetColumnFrozen(t *testing.T) {
+	tests := []struct {
+		name     string
+		frozen   []string
+		unfrozen []string
+		expected []bool
+		wantErr  bool
+	}{
+		{
+			name:     "first column",
+			frozen:   []string{"a"},
+			expected: []bool{true, false, false},
+		},
+		{
+			name:     "first two columns",
+			frozen:   []string{"a", "b"},
+			expected: []bool{true, true, false},
+		},
+		{
+			name:    "not from the left",
+			frozen:  []string{"b"},
+			wantErr: true,
+		},
+		{
+			name:    "gap",
+			frozen:  []string{"a", "c"},
+			wantErr: true,
+		},
+		{
+			name:     "unfreeze last frozen column",
+			frozen:   []string{"a", "b"},
+			unfrozen: []string{"b"},
+			expected: []bool{true, false, false},
+		},
+		{
+			name:     "unfreeze leaves a gap",
+			frozen:   []string{"a", "b"},
+			unfrozen: []string{"a"},
+			wantErr:  true,
+		},
+		{
+			name:    "unknown column",
+			frozen:  []string{"missing"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := NewTable("table", "placeholder", NewTableCols("a", "b", "c"))
+
+			var err error
+			for _, name := range tt.frozen {
+				if err = table.SetColumnFrozen(name, true); err != nil {
+					break
+				}
+			}
+			for _, name := range tt.unfrozen {
+				if err != nil {
+					break
+				}
+				err = table.SetColumnFrozen(name, false)
+			}
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []bool
+			for _, col := range table.Columns() {
+				got = append(got, col.Frozen)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTable_SetColumnFrozen_marshal(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("a", "b"))
+	require.NoError(t, table.SetColumnFrozen("a", true))
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	assert.Equal(t, table.Columns(), got.(*Table).Columns())
+}
+
+func TestTable_SetRowStatus(t *testing.T) {
+	table := NewTableWithRows("table", "placeholder", NewTableCols("name"), []TableRow{
+		{"name": NewText("ok-pod")},
+		{"name": NewText("failing-pod")},
+	})
+
+	require.NoError(t, table.SetRowStatus(0, StatusOK))
+	require.NoError(t, table.SetRowStatus(1, StatusError))
+	require.Error(t, table.SetRowStatus(2, StatusError))
+	require.False(t, table.IsEmpty())
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, table, got)
+
+	rows := got.(*Table).Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, string(StatusOK), rows[0][TableRowStatusKey].String())
+	assert.Equal(t, string(StatusError), rows[1][TableRowStatusKey].String())
+}
+
+func TestTable_ToCSV(t *testing.T) {
+	table := NewTableWithRows("table", "placeholder", NewTableCols("Name", "Labels", "Description"), []TableRow{
+		{
+			"Name":        NewLink("", "nginx", "/pods/nginx"),
+			"Labels":      NewText("app=nginx,tier=web"),
+			"Description": NewText(`says "hello"`),
+		},
+		{
+			"Name":        NewText("redis"),
+			"Description": NewText("cache"),
+		},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, table.ToCSV(&buf))
+
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "table.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), buf.String())
+}
+
+func TestTableFromStructs(t *testing.T) {
+	type pod struct {
+		Name     string
+		Restarts int   `octant:"Restart Count"`
+		Status   *Text `octant:"Phase"`
+	}
+
+	pods := []*pod{
+		{Name: "nginx", Restarts: 2, Status: NewText("Running")},
+		nil,
+		{Name: "redis", Restarts: 0},
+	}
+
+	got, err := TableFromStructs("Pods", pods, []string{"Name", "Restart Count", "Phase"})
+	require.NoError(t, err)
+
+	expected := NewTableWithRows("Pods", "", NewTableCols("Name", "Restart Count", "Phase"), []TableRow{
+		{
+			"Name":          NewText("nginx"),
+			"Restart Count": NewText("2"),
+			"Phase":         NewText("Running"),
+		},
+		{
+			"Name":          NewText("redis"),
+			"Restart Count": NewText("0"),
+			"Phase":         NewText(""),
+		},
+	})
+
+	assert.Equal(t, expected, got)
+}
+
+func TestTableFromStructs_errors(t *testing.T) {
+	type pod struct {
+		Name string
+	}
+
+	_, err := TableFromStructs("Pods", []pod{{Name: "nginx"}}, []string{"Name", "Age"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrColumnNotFound))
+
+	_, err = TableFromStructs("Pods", pod{Name: "nginx"}, []string{"Name"})
+	require.Error(t, err)
+
+	_, err = TableFromStructs("Pods", []string{"nginx"}, []string{"Name"})
+	require.Error(t, err)
+}
+
+func TestTable_AddSectionHeader(t *testing.T) {
+	table := NewTable("pods", "placeholder", NewTableCols("Name"))
+	table.AddSectionHeader("node-1")
+	assert.True(t, table.IsEmpty())
+
+	table.Add(TableRow{"Name": NewText("nginx")})
+	table.AddSectionHeader("node-2")
+	table.Add(TableRow{"Name": NewText("redis")})
+	assert.False(t, table.IsEmpty())
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, table, got)
+
+	rows := got.(*Table).Rows()
+	require.Len(t, rows, 4)
+	assert.True(t, rows[0].IsSectionHeader())
+	assert.Equal(t, NewText("node-1"), rows[0][TableSectionHeaderKey])
+	assert.False(t, rows[1].IsSectionHeader())
+	assert.True(t, rows[2].IsSectionHeader())
+
+	var buf bytes.Buffer
+	require.NoError(t, table.ToCSV(&buf))
+	assert.Equal(t, "Name\nnginx\nredis\n", buf.String())
+}
+
+func TestTable_Sort_sectionHeaders(t *testing.T) {
+	table := NewTable("pods", "placeholder", NewTableCols("Name"))
+	table.AddSectionHeader("node-1")
+	table.Add(
+		TableRow{"Name": NewText("redis")},
+		TableRow{"Name": NewText("nginx")},
+	)
+	table.AddSectionHeader("node-2")
+	table.Add(
+		TableRow{"Name": NewText("web")},
+		TableRow{"Name": NewText("api")},
+	)
+
+	table.Sort("Name", false)
+
+	var got []string
+	for _, row := range table.Rows() {
+		if row.IsSectionHeader() {
+			got = append(got, "# "+row[TableSectionHeaderKey].String())
+			continue
+		}
+		got = append(got, row["Name"].String())
+	}
+
+	expected := []string{"# node-1", "nginx", "redis", "# node-2", "api", "web"}
+	assert.Equal(t, expected, got)
+}
+
+func TestTable_EnableSelection(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("name"))
+	table.EnableSelection(GridAction{
+		Name:       "Delete",
+		ActionPath: "overview/deleteObjects",
+		Payload:    action.Payload{"kind": "Pod"},
+		Type:       GridActionDanger,
+	})
+
+	require.True(t, table.Config.Selectable)
+	require.Len(t, table.Config.BulkActions, 1)
+	require.True(t, table.IsEmpty())
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, table, got)
+	assert.True(t, got.(*Table).Config.Selectable)
+	assert.Equal(t, table.Config.BulkActions, got.(*Table).Config.BulkActions)
+}
+
+func TestPaginateTable(t *testing.T) {
+	table := NewTable("pods", "no pods", NewTableCols("name", "status"))
+	require.NoError(t, table.SetColumnFrozen("name", true))
+	for i := 0; i < 5; i++ {
+		table.Add(TableRow{
+			"name":   NewText(fmt.Sprintf("pod-%d", i)),
+			"status": NewText("Running"),
+		})
+	}
+
+	pages, err := PaginateTable(table, 2)
+	require.NoError(t, err)
+	require.Len(t, pages, 3)
+
+	var names [][]string
+	for _, page := range pages {
+		assert.Equal(t, table.Columns(), page.Columns())
+		assert.Equal(t, table.Config.EmptyContent, page.Config.EmptyContent)
+		assert.Equal(t, table.Metadata.Title, page.Metadata.Title)
+
+		var pageNames []string
+		for _, row := range page.Rows() {
+			pageNames = append(pageNames, row["name"].String())
+		}
+		names = append(names, pageNames)
+	}
+
+	assert.Equal(t, [][]string{
+		{"pod-0", "pod-1"},
+		{"pod-2", "pod-3"},
+		{"pod-4"},
+	}, names)
+
+	empty, err := PaginateTable(NewTable("pods", "no pods", NewTableCols("name")), 2)
+	require.NoError(t, err)
+	require.Len(t, empty, 1)
+	assert.True(t, empty[0].IsEmpty())
+
+	_, err = PaginateTable(table, 0)
+	require.Error(t, err)
+}
+
+func TestPaginateTable_independentPages(t *testing.T) {
+	table := NewTableWithRows("pods", "no pods", NewTableCols("name"), []TableRow{
+		{"name": NewText("pod-0")},
+		{"name": NewText("pod-1")},
+	})
+	table.SetTitleText("pods")
+	table.EnableSelection(GridAction{Name: "Delete", ActionPath: "delete"})
+	buttonGroup := NewButtonGroup()
+	buttonGroup.AddButton(NewButton("Refresh", action.Payload{}))
+	table.Config.ButtonGroup = buttonGroup
+
+	pages, err := PaginateTable(table, 1)
+	require.NoError(t, err)
+	require.Len(t, pages, 2)
+
+	pages[0].Metadata.Title[0] = NewText("changed")
+	pages[0].Config.BulkActions[0].Name = "Changed"
+	pages[0].Config.ButtonGroup.Config.Buttons[0].Name = "Changed"
+	pages[0].Config.ButtonGroup.AddButton(NewButton("Extra", action.Payload{}))
+
+	for _, other := range []*Table{table, pages[1]} {
+		assert.Equal(t, Title(NewText("pods")), other.Metadata.Title)
+		assert.Equal(t, "Delete", other.Config.BulkActions[0].Name)
+		require.Len(t, other.Config.ButtonGroup.Config.Buttons, 1)
+		assert.Equal(t, "Refresh", other.Config.ButtonGroup.Config.Buttons[0].Name)
+	}
+}
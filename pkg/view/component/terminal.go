@@ -7,12 +7,18 @@ package component
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 type TerminalDetails struct {
-	Container string    `json:"container"`
-	Command   string    `json:"command"`
+	Container string `json:"container"`
+	Command   string `json:"command"`
+	// Args are the arguments of the command. Unlike Command, they keep
+	// argument boundaries.
+	Args      []string  `json:"args,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
 	Active    bool      `json:"active"`
 }
@@ -24,6 +30,8 @@ type TerminalConfig struct {
 	PodName    string          `json:"podName"`
 	Containers []string        `json:"containers"`
 	Details    TerminalDetails `json:"terminal"`
+	// Scrollback is output shown before the session's output.
+	Scrollback string `json:"scrollback,omitempty"`
 }
 
 // Terminal is a terminal component.
@@ -48,6 +56,38 @@ func NewTerminal(namespace, name string, podName string, containers []string, de
 	}
 }
 
+// NewExecTerminal creates a Terminal component for an interactive session
+// running command in a pod's container. Namespace, pod, container, and
+// command are required. The command's arguments are kept unchanged in Args,
+// and Command is their space separated form for display.
+func NewExecTerminal(namespace, pod, container string, command []string) (*Terminal, error) {
+	switch {
+	case namespace == "":
+		return nil, errors.New("terminal namespace is required")
+	case pod == "":
+		return nil, errors.New("terminal pod is required")
+	case container == "":
+		return nil, errors.New("terminal container is required")
+	case len(command) == 0:
+		return nil, errors.New("terminal command is required")
+	}
+
+	details := TerminalDetails{
+		Container: container,
+		Command:   strings.Join(command, " "),
+		Args:      append([]string(nil), command...),
+		Active:    true,
+	}
+
+	return NewTerminal(namespace, "Terminal", pod, []string{container}, details), nil
+}
+
+// SetScrollback sets output shown before the session's output, e.g. from a
+// previous session.
+func (t *Terminal) SetScrollback(scrollback string) {
+	t.Config.Scrollback = scrollback
+}
+
 // GetMetadata accesses the components metadata. Implements Component.
 func (t *Terminal) GetMetadata() Metadata {
 	return t.Metadata
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTerminal_Marshal(t *testing.T) {
@@ -29,3 +30,34 @@ func TestTerminal_Marshal(t *testing.T) {
 
 	assert.JSONEq(t, string(expected), string(actual))
 }
+
+func TestNewExecTerminal(t *testing.T) {
+	term, err := NewExecTerminal("default", "nginx", "web", []string{"/bin/sh", "-c", "ls -la"})
+	require.NoError(t, err)
+	term.SetScrollback("$ ls\nindex.html\n")
+
+	assert.Equal(t, "/bin/sh -c ls -la", term.Config.Details.Command)
+	assert.Equal(t, []string{"/bin/sh", "-c", "ls -la"}, term.Config.Details.Args)
+	assert.Equal(t, []string{"web"}, term.Config.Containers)
+
+	data, err := json.Marshal(term)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, term, got)
+	assert.Equal(t, []string{"/bin/sh", "-c", "ls -la"}, got.(*Terminal).Config.Details.Args)
+
+	_, err = NewExecTerminal("", "nginx", "web", []string{"sh"})
+	require.Error(t, err)
+	_, err = NewExecTerminal("default", "", "web", []string{"sh"})
+	require.Error(t, err)
+	_, err = NewExecTerminal("default", "nginx", "", []string{"sh"})
+	require.Error(t, err)
+	_, err = NewExecTerminal("default", "nginx", "web", nil)
+	require.Error(t, err)
+}
@@ -8,6 +8,9 @@ package component
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
 )
 
 // TextStatus is the status of a text component
@@ -27,6 +30,8 @@ const (
 type Text struct {
 	Base
 	Config TextConfig `json:"config"`
+
+	allowControlChars bool
 }
 
 // TextConfig is the contents of Text
@@ -37,6 +42,27 @@ type TextConfig struct {
 	IsMarkdown bool `json:"isMarkdown,omitempty"`
 	// Status sets the status of the component.
 	Status TextStatus `json:"status,omitempty"`
+	// I18nKey is a message key clients can use to localize the text. Clients
+	// which don't localize will display Text.
+	I18nKey string `json:"i18nKey,omitempty"`
+	// I18nParams are the parameters for the message key.
+	I18nParams map[string]string `json:"i18nParams,omitempty"`
+	// CopyValue is the value copied to the clipboard if it differs from the
+	// displayed text.
+	CopyValue string `json:"copyValue,omitempty"`
+	// Runs is the text split into plain text and links. It is only set if
+	// auto linking is enabled.
+	Runs []TextRun `json:"runs,omitempty"`
+	// Expandable is true if Preview is shown until the user expands the text.
+	Expandable bool `json:"expandable,omitempty"`
+	// Preview is the start of the text shown before it is expanded.
+	Preview string `json:"preview,omitempty"`
+}
+
+// TextRun is a part of a text value. If URL is set, the run is a link.
+type TextRun struct {
+	Text string `json:"text"`
+	URL  string `json:"url,omitempty"`
 }
 
 // NewText creates a text component
@@ -55,11 +81,106 @@ func NewText(s string, options ...func(*Text)) *Text {
 	return t
 }
 
+// AllowControlChars is an option which allows control characters in the
+// text value. By default, control characters other than tabs and newlines are
+// removed when the component is marshaled.
+func AllowControlChars() func(*Text) {
+	return func(t *Text) {
+		t.allowControlChars = true
+	}
+}
+
+// TextWithCopyValue is an option which sets the value copied to the
+// clipboard. This allows a shortened value, e.g. a truncated hash, to be
+// displayed while the full value is copied.
+func TextWithCopyValue(full string) func(*Text) {
+	return func(t *Text) {
+		t.Config.CopyValue = full
+	}
+}
+
+// TextAutoLink is an option which converts URLs in the text value to links.
+func TextAutoLink() func(*Text) {
+	return func(t *Text) {
+		t.Config.Runs = textRuns(t.Config.Text)
+	}
+}
+
+// TextExpandable is an option which shows the first previewLen runes of the
+// text value until the user expands it. Values which are no longer than
+// previewLen are shown in full.
+func TextExpandable(previewLen int) func(*Text) {
+	return func(t *Text) {
+		runes := []rune(t.Config.Text)
+		if previewLen < 1 || len(runes) <= previewLen {
+			return
+		}
+
+		t.Config.Expandable = true
+		t.Config.Preview = string(runes[:previewLen])
+	}
+}
+
+var textURLRe = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// textRuns splits s into plain text and URLs. Trailing punctuation is not
+// considered part of a URL.
+func textRuns(s string) []TextRun {
+	var runs []TextRun
+	start := 0
+
+	for _, loc := range textURLRe.FindAllStringIndex(s, -1) {
+		url := trimURLPunctuation(s[loc[0]:loc[1]])
+		if url == "" {
+			continue
+		}
+
+		if loc[0] > start {
+			runs = append(runs, TextRun{Text: s[start:loc[0]]})
+		}
+		runs = append(runs, TextRun{Text: url, URL: url})
+		start = loc[0] + len(url)
+	}
+
+	if start < len(s) {
+		runs = append(runs, TextRun{Text: s[start:]})
+	}
+
+	return runs
+}
+
+// trimURLPunctuation removes trailing punctuation from url. A closing
+// parenthesis is kept if the URL contains an opening one.
+func trimURLPunctuation(url string) string {
+	for len(url) > 0 {
+		last := url[len(url)-1]
+		switch {
+		case strings.IndexByte(".,;:!?'\"]", last) != -1:
+		case last == ')' && strings.Count(url, "(") < strings.Count(url, ")"):
+		default:
+			return url
+		}
+		url = url[:len(url)-1]
+	}
+
+	return url
+}
+
 // NewTextf creates a a text component using a printf like helper.
 func NewTextf(format string, a ...interface{}) *Text {
 	return NewText(fmt.Sprintf(format, a...))
 }
 
+// NewTextI18n creates a text component with a localization key and params.
+// The key is used as the text value for clients which don't localize.
+func NewTextI18n(key string, params map[string]string, options ...func(*Text)) *Text {
+	t := NewText(key, options...)
+	t.Config.I18nKey = key
+	t.Config.I18nParams = params
+
+	return t
+}
+
 // NewMarkdownText creates a text component styled with markdown.
 func NewMarkdownText(s string) *Text {
 	t := NewText(s)
@@ -97,9 +218,37 @@ type textMarshal Text
 func (t *Text) MarshalJSON() ([]byte, error) {
 	m := textMarshal(*t)
 	m.Metadata.Type = TypeText
+	if !t.allowControlChars {
+		m.Config.Text = sanitizeText(m.Config.Text)
+		m.Config.Preview = sanitizeText(m.Config.Preview)
+		if len(m.Config.Runs) > 0 {
+			// Rebuild the runs so escape sequences can't end up in links.
+			m.Config.Runs = textRuns(m.Config.Text)
+		}
+	}
 	return json.Marshal(&m)
 }
 
+var reANSIEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+
+// sanitizeText removes ANSI escape sequences and control characters other
+// than tabs and newlines from s.
+func sanitizeText(s string) string {
+	s = reANSIEscape.ReplaceAllString(s, "")
+
+	return strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || !unicode.IsControl(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// IsEmpty returns true if the text value is empty.
+func (t *Text) IsEmpty() bool {
+	return t.Config.Text == ""
+}
+
 // String returns the text content of the component.
 func (t *Text) String() string {
 	return t.Config.Text
@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// DiffLineType is the type of a line in a diff.
+type DiffLineType string
+
+const (
+	// DiffLineContext is a line which is the same in both inputs.
+	DiffLineContext DiffLineType = "context"
+	// DiffLineAdd is a line which was added.
+	DiffLineAdd DiffLineType = "add"
+	// DiffLineDelete is a line which was deleted.
+	DiffLineDelete DiffLineType = "delete"
+)
+
+// DiffLine is a line in a diff.
+type DiffLine struct {
+	Type DiffLineType `json:"type"`
+	Text string       `json:"text"`
+}
+
+// TextDiffConfig is the contents of TextDiff.
+type TextDiffConfig struct {
+	// Lines are the lines of the diff.
+	Lines []DiffLine `json:"lines"`
+}
+
+// TextDiff is a component showing a line level diff between two strings.
+//
+// +octant:component
+type TextDiff struct {
+	Base
+	Config TextDiffConfig `json:"config"`
+}
+
+var _ Component = (*TextDiff)(nil)
+
+// NewTextDiff creates a text diff component showing the changes required to
+// turn before into after.
+func NewTextDiff(before, after string) *TextDiff {
+	return &TextDiff{
+		Base: newBase(TypeTextDiff, nil),
+		Config: TextDiffConfig{
+			Lines: diffLines(splitLines(before), splitLines(after)),
+		},
+	}
+}
+
+// IsEmpty returns true if there are no added or deleted lines.
+func (td *TextDiff) IsEmpty() bool {
+	for _, line := range td.Config.Lines {
+		if line.Type != DiffLineContext {
+			return false
+		}
+	}
+
+	return true
+}
+
+type textDiffMarshal TextDiff
+
+// MarshalJSON implements json.Marshaler
+func (td *TextDiff) MarshalJSON() ([]byte, error) {
+	m := textDiffMarshal(*td)
+	m.Metadata.Type = TypeTextDiff
+	return json.Marshal(&m)
+}
+
+// String returns the diff in unified format.
+func (td *TextDiff) String() string {
+	var sb strings.Builder
+	for _, line := range td.Config.Lines {
+		switch line.Type {
+		case DiffLineAdd:
+			sb.WriteString("+")
+		case DiffLineDelete:
+			sb.WriteString("-")
+		default:
+			sb.WriteString(" ")
+		}
+		sb.WriteString(line.Text)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// maxDiffCells is the largest number of cells in the table used to diff the
+// lines following the common prefix of two inputs. Larger inputs are shown as
+// all lines being replaced.
+const maxDiffCells = 1 << 22
+
+// diffLines computes a diff of a and b using their longest common subsequence.
+// If the table for the lines after their common prefix would have more than
+// maxDiffCells cells, those lines are shown as deleted and then added.
+func diffLines(a, b []string) []DiffLine {
+	lines := []DiffLine{}
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		lines = append(lines, DiffLine{Type: DiffLineContext, Text: a[0]})
+		a, b = a[1:], b[1:]
+	}
+
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, line := range a {
+			lines = append(lines, DiffLine{Type: DiffLineDelete, Text: line})
+		}
+		for _, line := range b {
+			lines = append(lines, DiffLine{Type: DiffLineAdd, Text: line})
+		}
+		return lines
+	}
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, DiffLine{Type: DiffLineContext, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, DiffLine{Type: DiffLineDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Type: DiffLineAdd, Text: b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		lines = append(lines, DiffLine{Type: DiffLineDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, DiffLine{Type: DiffLineAdd, Text: b[j]})
+	}
+
+	return lines
+}
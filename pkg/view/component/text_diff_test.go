@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTextDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		before    string
+		after     string
+		expected  []DiffLine
+		wantEmpty bool
+	}{
+		{
+			name:   "insertion",
+			before: "a\nc\n",
+			after:  "a\nb\nc\n",
+			expected: []DiffLine{
+				{Type: DiffLineContext, Text: "a"},
+				{Type: DiffLineAdd, Text: "b"},
+				{Type: DiffLineContext, Text: "c"},
+			},
+		},
+		{
+			name:   "deletion",
+			before: "a\nb\nc",
+			after:  "a\nc",
+			expected: []DiffLine{
+				{Type: DiffLineContext, Text: "a"},
+				{Type: DiffLineDelete, Text: "b"},
+				{Type: DiffLineContext, Text: "c"},
+			},
+		},
+		{
+			name:   "no change",
+			before: "a\nb",
+			after:  "a\nb",
+			expected: []DiffLine{
+				{Type: DiffLineContext, Text: "a"},
+				{Type: DiffLineContext, Text: "b"},
+			},
+			wantEmpty: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := NewTextDiff(tt.before, tt.after)
+			assert.Equal(t, tt.expected, td.Config.Lines)
+			assert.Equal(t, tt.wantEmpty, td.IsEmpty())
+		})
+	}
+}
+
+func TestTextDiff_Marshal(t *testing.T) {
+	td := NewTextDiff("a\nb", "a\nc")
+
+	data, err := json.Marshal(td)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, td, got)
+	assert.Equal(t, " a\n-b\n+c\n", td.String())
+}
+
+func TestNewTextDiff_large(t *testing.T) {
+	var before, after strings.Builder
+	before.WriteString("apiVersion: v1\n")
+	after.WriteString("apiVersion: v1\n")
+	for i := 0; i < 2100; i++ {
+		fmt.Fprintf(&before, "old-%d\n", i)
+		fmt.Fprintf(&after, "new-%d\n", i)
+	}
+
+	td := NewTextDiff(before.String(), after.String())
+	require.Len(t, td.Config.Lines, 1+2*2100)
+
+	assert.Equal(t, DiffLine{Type: DiffLineContext, Text: "apiVersion: v1"}, td.Config.Lines[0])
+	for i, line := range td.Config.Lines[1:] {
+		if i < 2100 {
+			assert.Equal(t, DiffLine{Type: DiffLineDelete, Text: fmt.Sprintf("old-%d", i)}, line)
+		} else {
+			assert.Equal(t, DiffLine{Type: DiffLineAdd, Text: fmt.Sprintf("new-%d", i-2100)}, line)
+		}
+	}
+}
@@ -136,3 +136,171 @@ func Test_Text_LessThan(t *testing.T) {
 		})
 	}
 }
+
+func Test_Text_I18n(t *testing.T) {
+	params := map[string]string{"count": "3"}
+	text := NewTextI18n("pods.running", params, func(t *Text) {
+		t.Config.Text = "3 pods running"
+	})
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "text"
+  },
+  "config": {
+    "value": "3 pods running",
+    "i18nKey": "pods.running",
+    "i18nParams": {
+      "count": "3"
+    }
+  }
+}
+`
+	assert.JSONEq(t, expected, string(data))
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, text, got)
+	require.Equal(t, "3 pods running", got.String())
+}
+
+func Test_Text_ControlChars(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     *Text
+		expected string
+	}{
+		{
+			name:     "ansi escape is removed",
+			text:     NewText("\x1b[31merror\x1b[0m: failed"),
+			expected: "error: failed",
+		},
+		{
+			name:     "control characters are removed",
+			text:     NewText("a\x00b\rc\td\ne"),
+			expected: "abc\td\ne",
+		},
+		{
+			name:     "control characters are allowed",
+			text:     NewText("\x1b[31merror", AllowControlChars()),
+			expected: "\x1b[31merror",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.text)
+			require.NoError(t, err)
+
+			var to TypedObject
+			require.NoError(t, json.Unmarshal(data, &to))
+
+			got, err := to.ToComponent()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got.String())
+		})
+	}
+}
+
+func Test_Text_CopyValue(t *testing.T) {
+	text := NewText("3f2a9c1", TextWithCopyValue("3f2a9c1d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39"))
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, text, got)
+
+	gotText := got.(*Text)
+	assert.Equal(t, "3f2a9c1", gotText.Config.Text)
+	assert.Equal(t, "3f2a9c1d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39", gotText.Config.CopyValue)
+	assert.NotEqual(t, gotText.Config.Text, gotText.Config.CopyValue)
+}
+
+func Test_Text_AutoLink(t *testing.T) {
+	text := NewText("See https://octant.dev/docs, or (https://github.com/vmware-tanzu/octant).", TextAutoLink())
+
+	expected := []TextRun{
+		{Text: "See "},
+		{Text: "https://octant.dev/docs", URL: "https://octant.dev/docs"},
+		{Text: ", or ("},
+		{Text: "https://github.com/vmware-tanzu/octant", URL: "https://github.com/vmware-tanzu/octant"},
+		{Text: ")."},
+	}
+	assert.Equal(t, expected, text.Config.Runs)
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, text, got)
+
+	assert.Nil(t, NewText("no links here").Config.Runs)
+	assert.Equal(t, []TextRun{{Text: "https://en.wikipedia.org/wiki/Go_(language)", URL: "https://en.wikipedia.org/wiki/Go_(language)"}},
+		NewText("https://en.wikipedia.org/wiki/Go_(language)", TextAutoLink()).Config.Runs)
+}
+
+func Test_Text_AutoLink_ansi(t *testing.T) {
+	text := NewText("docs: \x1b[4mhttps://octant.dev/docs\x1b[0m\a", TextAutoLink())
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	expected := []TextRun{
+		{Text: "docs: "},
+		{Text: "https://octant.dev/docs", URL: "https://octant.dev/docs"},
+	}
+	assert.Equal(t, "docs: https://octant.dev/docs", got.(*Text).Config.Text)
+	assert.Equal(t, expected, got.(*Text).Config.Runs)
+}
+
+func Test_Text_Expandable(t *testing.T) {
+	value := "Événement 1: pod scheduled\nÉvénement 2: image pulled\nÉvénement 3: container started"
+	text := NewText(value, TextExpandable(12))
+
+	assert.True(t, text.Config.Expandable)
+	assert.Equal(t, "Événement 1:", text.Config.Preview)
+	assert.Equal(t, value, text.Config.Text)
+	assert.False(t, text.IsEmpty())
+
+	data, err := json.Marshal(text)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, text, got)
+
+	short := NewText("short", TextExpandable(12))
+	assert.False(t, short.Config.Expandable)
+	assert.Empty(t, short.Config.Preview)
+
+	assert.True(t, NewText("", TextExpandable(12)).IsEmpty())
+}
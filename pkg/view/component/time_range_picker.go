@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TimeRangePreset is a preset time range ending now.
+type TimeRangePreset struct {
+	// Label is the label of the preset.
+	Label string `json:"label"`
+	// DurationSeconds is the length of the range.
+	DurationSeconds int64 `json:"durationSeconds"`
+}
+
+// TimeRangePickerConfig is the contents of TimeRangePicker.
+type TimeRangePickerConfig struct {
+	// Start is the start of the range in seconds since epoch.
+	Start int64 `json:"start,omitempty"`
+	// End is the end of the range in seconds since epoch.
+	End int64 `json:"end,omitempty"`
+	// Presets are the preset ranges.
+	Presets []TimeRangePreset `json:"presets"`
+	// Action is the action path invoked with the selected range.
+	Action string `json:"action,omitempty"`
+}
+
+// TimeRangePicker is a component for picking a time range.
+//
+// +octant:component
+type TimeRangePicker struct {
+	Base
+	Config TimeRangePickerConfig `json:"config"`
+}
+
+var _ Component = (*TimeRangePicker)(nil)
+
+// NewTimeRangePicker creates a time range picker component with presets for
+// the last hour, 6 hours, and 24 hours.
+func NewTimeRangePicker() *TimeRangePicker {
+	trp := &TimeRangePicker{
+		Base: newBase(TypeTimeRangePicker, nil),
+	}
+
+	trp.AddPreset("Last 1h", time.Hour)
+	trp.AddPreset("Last 6h", 6*time.Hour)
+	trp.AddPreset("Last 24h", 24*time.Hour)
+
+	return trp
+}
+
+// AddPreset adds a preset for the range of length d ending now. The length
+// is truncated to seconds.
+func (trp *TimeRangePicker) AddPreset(label string, d time.Duration) {
+	trp.Config.Presets = append(trp.Config.Presets, TimeRangePreset{
+		Label:           label,
+		DurationSeconds: int64(d / time.Second),
+	})
+}
+
+// SetRange sets the selected range. A zero time leaves that bound unset. If
+// both bounds are set, start must be before end.
+func (trp *TimeRangePicker) SetRange(start, end time.Time) error {
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return errors.Errorf("start %s is not before end %s", start, end)
+	}
+
+	trp.Config.Start = 0
+	if !start.IsZero() {
+		trp.Config.Start = start.Unix()
+	}
+
+	trp.Config.End = 0
+	if !end.IsZero() {
+		trp.Config.End = end.Unix()
+	}
+
+	return nil
+}
+
+// SetAction sets the action path invoked when a range is selected. The
+// payload contains the start and end of the range.
+func (trp *TimeRangePicker) SetAction(actionPath string) {
+	trp.Config.Action = actionPath
+}
+
+// IsEmpty returns false. A time range picker always has presets.
+func (trp *TimeRangePicker) IsEmpty() bool {
+	return false
+}
+
+type timeRangePickerMarshal TimeRangePicker
+
+// MarshalJSON implements json.Marshaler
+func (trp *TimeRangePicker) MarshalJSON() ([]byte, error) {
+	m := timeRangePickerMarshal(*trp)
+	m.Metadata.Type = TypeTimeRangePicker
+	return json.Marshal(&m)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeRangePicker(t *testing.T) {
+	trp := NewTimeRangePicker()
+	trp.AddPreset("Last 7d", 7*24*time.Hour)
+	trp.SetAction("overview/setTimeRange")
+
+	start := time.Unix(1600000000, 0)
+	end := start.Add(time.Hour)
+
+	require.Error(t, trp.SetRange(end, start))
+	require.Error(t, trp.SetRange(start, start))
+	require.NoError(t, trp.SetRange(start, time.Time{}))
+	require.NoError(t, trp.SetRange(start, end))
+
+	assert.Equal(t, []TimeRangePreset{
+		{Label: "Last 1h", DurationSeconds: 3600},
+		{Label: "Last 6h", DurationSeconds: 21600},
+		{Label: "Last 24h", DurationSeconds: 86400},
+		{Label: "Last 7d", DurationSeconds: 604800},
+	}, trp.Config.Presets)
+
+	data, err := json.Marshal(trp)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, trp, got)
+	assert.Equal(t, int64(1600000000), got.(*TimeRangePicker).Config.Start)
+	assert.Equal(t, int64(1600003600), got.(*TimeRangePicker).Config.End)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+)
+
+// ToggleConfig is the contents of Toggle.
+type ToggleConfig struct {
+	// Label is the label of the toggle.
+	Label string `json:"label"`
+	// On is the current state of the toggle.
+	On bool `json:"on"`
+	// Action is the action path invoked when the toggle is switched. The
+	// payload contains the new state.
+	Action string `json:"action"`
+	// OnLabel is shown when the toggle is on.
+	OnLabel string `json:"onLabel,omitempty"`
+	// OffLabel is shown when the toggle is off.
+	OffLabel string `json:"offLabel,omitempty"`
+}
+
+// Toggle is a component for a switch bound to an action, e.g. to pause and
+// resume a rollout.
+//
+// +octant:component
+type Toggle struct {
+	Base
+	Config ToggleConfig `json:"config"`
+}
+
+var _ Component = (*Toggle)(nil)
+
+// NewToggle creates a toggle component.
+func NewToggle(label string, on bool, actionPath string) *Toggle {
+	return &Toggle{
+		Base: newBase(TypeToggle, nil),
+		Config: ToggleConfig{
+			Label:  label,
+			On:     on,
+			Action: actionPath,
+		},
+	}
+}
+
+// SetStateLabels sets the labels shown when the toggle is on and off.
+func (t *Toggle) SetStateLabels(on, off string) {
+	t.Config.OnLabel = on
+	t.Config.OffLabel = off
+}
+
+// String returns the label for the toggle's state, or its label if state
+// labels aren't set.
+func (t *Toggle) String() string {
+	label := t.Config.OffLabel
+	if t.Config.On {
+		label = t.Config.OnLabel
+	}
+
+	if label == "" {
+		return t.Config.Label
+	}
+
+	return label
+}
+
+type toggleMarshal Toggle
+
+// MarshalJSON implements json.Marshaler
+func (t *Toggle) MarshalJSON() ([]byte, error) {
+	m := toggleMarshal(*t)
+	m.Metadata.Type = TypeToggle
+	return json.Marshal(&m)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewToggle(t *testing.T) {
+	toggle := NewToggle("Rollout", true, "overview/pauseRollout")
+	toggle.SetStateLabels("Running", "Paused")
+	assert.Equal(t, "Running", toggle.String())
+
+	data, err := json.Marshal(toggle)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	action, err := to.GetString("action")
+	require.NoError(t, err)
+	assert.Equal(t, "overview/pauseRollout", action)
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, toggle, got)
+	assert.True(t, got.(*Toggle).Config.On)
+
+	assert.Equal(t, "Debug", NewToggle("Debug", false, "overview/debug").String())
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Container is a component which contains other components.
+type Container interface {
+	Component
+
+	// Children returns the components contained by this component.
+	Children() []Component
+}
+
+var (
+	_ Container = (*Card)(nil)
+	_ Container = (*CardList)(nil)
+	_ Container = (*Extension)(nil)
+	_ Container = (*FlexLayout)(nil)
+	_ Container = (*List)(nil)
+	_ Container = (*Summary)(nil)
+	_ Container = (*Table)(nil)
+)
+
+// TreeStats describes the size and shape of a component tree.
+type TreeStats struct {
+	// Counts is the number of components by type.
+	Counts map[string]int
+	// Total is the total number of components.
+	Total int
+	// MaxDepth is the depth of the deepest component. The root has a depth of 1.
+	MaxDepth int
+}
+
+// Stats returns statistics for the component tree starting at root.
+func Stats(root Component) TreeStats {
+	stats := TreeStats{
+		Counts: map[string]int{},
+	}
+
+	walk(root, 1, func(c Component, depth int) {
+		stats.Counts[c.GetMetadata().Type]++
+		stats.Total++
+		if depth > stats.MaxDepth {
+			stats.MaxDepth = depth
+		}
+	})
+
+	return stats
+}
+
+// ValidateAccessors returns an error if more than one component in the tree
+// starting at root has the same accessor. Components without an accessor are
+// ignored.
+func ValidateAccessors(root Component) error {
+	types := map[string][]string{}
+	walk(root, 1, func(c Component, _ int) {
+		metadata := c.GetMetadata()
+		if metadata.Accessor == "" {
+			return
+		}
+		types[metadata.Accessor] = append(types[metadata.Accessor], metadata.Type)
+	})
+
+	var duplicates []string
+	for accessor, componentTypes := range types {
+		if len(componentTypes) > 1 {
+			duplicates = append(duplicates,
+				accessor+" ("+strings.Join(componentTypes, ", ")+")")
+		}
+	}
+
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	sort.Strings(duplicates)
+	return errors.Errorf("duplicate accessors: %s", strings.Join(duplicates, "; "))
+}
+
+// FindByType returns the components in the tree starting at root whose type
+// is typ. Components are returned in depth first order.
+func FindByType(root Component, typ string) []Component {
+	var found []Component
+	walk(root, 1, func(c Component, _ int) {
+		if c.GetMetadata().Type == typ {
+			found = append(found, c)
+		}
+	})
+
+	return found
+}
+
+// walk visits c and its descendants depth first. Nil components are skipped.
+func walk(c Component, depth int, fn func(c Component, depth int)) {
+	if c == nil {
+		return
+	}
+
+	fn(c, depth)
+
+	container, ok := c.(Container)
+	if !ok {
+		return
+	}
+
+	for _, child := range container.Children() {
+		walk(child, depth+1, fn)
+	}
+}
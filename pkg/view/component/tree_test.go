@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats(t *testing.T) {
+	table := NewTableWithRows("table", "placeholder", NewTableCols("name", "link"), []TableRow{
+		{"name": NewText("a"), "link": NewLink("", "a", "/a")},
+		{"name": NewText("b"), "link": NewLink("", "b", "/b")},
+	})
+
+	card := NewCard(TitleFromString("card"))
+	card.SetBody(NewText("body"))
+
+	summary := NewSummary("summary")
+	summary.AddSection("section", NewText("content"))
+
+	layout := NewFlexLayout("layout")
+	layout.AddSections(FlexLayoutSection{
+		{Width: WidthHalf, View: table},
+		{Width: WidthHalf, View: NewList(nil, []Component{card, summary})},
+	})
+
+	got := Stats(layout)
+
+	expected := TreeStats{
+		Counts: map[string]int{
+			TypeFlexLayout: 1,
+			TypeTable:      1,
+			TypeText:       4,
+			TypeLink:       2,
+			TypeList:       1,
+			TypeCard:       1,
+			TypeSummary:    1,
+		},
+		Total:    11,
+		MaxDepth: 4,
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestStats_leaf(t *testing.T) {
+	got := Stats(NewText("text"))
+
+	expected := TreeStats{
+		Counts:   map[string]int{TypeText: 1},
+		Total:    1,
+		MaxDepth: 1,
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestValidateAccessors(t *testing.T) {
+	text := NewText("text")
+	text.SetAccessor("details")
+
+	table := NewTable("table", "placeholder", NewTableCols("name"))
+	table.SetAccessor("pods")
+
+	list := NewList(nil, []Component{text, table, NewText("no accessor"), NewText("no accessor")})
+	require.NoError(t, ValidateAccessors(list))
+
+	duplicate := NewTable("other table", "placeholder", NewTableCols("name"))
+	duplicate.SetAccessor("details")
+	list.Add(duplicate)
+
+	err := ValidateAccessors(list)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "details (text, table)")
+}
+
+func TestFindByType(t *testing.T) {
+	pods := NewTable("pods", "placeholder", NewTableCols("name"))
+	services := NewTable("services", "placeholder", NewTableCols("name"))
+	secrets := NewTable("secrets", "placeholder", NewTableCols("name"))
+
+	card := NewCard(TitleFromString("card"))
+	card.SetBody(secrets)
+
+	layout := NewFlexLayout("layout")
+	layout.AddSections(
+		FlexLayoutSection{{Width: WidthFull, View: pods}},
+		FlexLayoutSection{
+			{Width: WidthHalf, View: NewList(nil, []Component{services, NewText("text")})},
+			{Width: WidthHalf, View: card},
+		},
+	)
+
+	got := FindByType(layout, TypeTable)
+	assert.Equal(t, []Component{pods, services, secrets}, got)
+
+	assert.Len(t, FindByType(layout, TypeText), 1)
+	assert.Empty(t, FindByType(layout, TypeGraphviz))
+}
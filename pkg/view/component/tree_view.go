@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// TreeNode is a node in a tree view.
+type TreeNode struct {
+	// Label is the component shown for the node.
+	Label Component `json:"label"`
+	// Expanded is true if the node's children are shown initially.
+	Expanded bool `json:"expanded,omitempty"`
+	// Children are the node's children.
+	Children []*TreeNode `json:"children,omitempty"`
+}
+
+// NewTreeNode creates a tree node with a label and children.
+func NewTreeNode(label Component, children ...*TreeNode) *TreeNode {
+	return &TreeNode{
+		Label:    label,
+		Children: children,
+	}
+}
+
+// AddChild adds children to the node.
+func (n *TreeNode) AddChild(children ...*TreeNode) {
+	n.Children = append(n.Children, children...)
+}
+
+// UnmarshalJSON unmarshals a tree node from JSON.
+func (n *TreeNode) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Label    TypedObject `json:"label"`
+		Expanded bool        `json:"expanded,omitempty"`
+		Children []*TreeNode `json:"children,omitempty"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	label, err := x.Label.ToComponent()
+	if err != nil {
+		return err
+	}
+
+	n.Label = label
+	n.Expanded = x.Expanded
+	n.Children = x.Children
+
+	return nil
+}
+
+// TreeViewConfig is the contents of TreeView.
+type TreeViewConfig struct {
+	Nodes []*TreeNode `json:"nodes"`
+}
+
+// TreeView is a component which renders hierarchical data as a tree with
+// expandable nodes.
+//
+// +octant:component
+type TreeView struct {
+	Base
+	Config TreeViewConfig `json:"config"`
+}
+
+var _ Container = (*TreeView)(nil)
+
+// NewTreeView creates a tree view component.
+func NewTreeView() *TreeView {
+	return &TreeView{
+		Base: newBase(TypeTreeView, nil),
+	}
+}
+
+// AddNode adds a root node to the tree. An error is returned if the node
+// contains a cycle.
+func (tv *TreeView) AddNode(node *TreeNode) error {
+	if err := validateTreeNode(node, map[*TreeNode]bool{}); err != nil {
+		return err
+	}
+
+	tv.Config.Nodes = append(tv.Config.Nodes, node)
+	return nil
+}
+
+// validateTreeNode returns an error if node is nil or one of its descendants
+// is also its ancestor.
+func validateTreeNode(node *TreeNode, ancestors map[*TreeNode]bool) error {
+	if node == nil {
+		return errors.New("tree node is nil")
+	}
+
+	if ancestors[node] {
+		return errors.Errorf("tree node %q is its own ancestor", labelString(node.Label))
+	}
+
+	ancestors[node] = true
+	defer delete(ancestors, node)
+
+	for _, child := range node.Children {
+		if err := validateTreeNode(child, ancestors); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func labelString(c Component) string {
+	if c == nil {
+		return ""
+	}
+	return c.String()
+}
+
+// IsEmpty returns true if the tree has no nodes.
+func (tv *TreeView) IsEmpty() bool {
+	return len(tv.Config.Nodes) == 0
+}
+
+// Children returns the labels of all nodes in the tree.
+func (tv *TreeView) Children() []Component {
+	var children []Component
+
+	var visit func(nodes []*TreeNode)
+	visit = func(nodes []*TreeNode) {
+		for _, node := range nodes {
+			if node.Label != nil {
+				children = append(children, node.Label)
+			}
+			visit(node.Children)
+		}
+	}
+	visit(tv.Config.Nodes)
+
+	return children
+}
+
+type treeViewMarshal TreeView
+
+// MarshalJSON implements json.Marshaler. An error is returned if the tree
+// contains a cycle.
+func (tv *TreeView) MarshalJSON() ([]byte, error) {
+	for _, node := range tv.Config.Nodes {
+		if err := validateTreeNode(node, map[*TreeNode]bool{}); err != nil {
+			return nil, err
+		}
+	}
+
+	m := treeViewMarshal(*tv)
+	m.Metadata.Type = TypeTreeView
+	return json.Marshal(&m)
+}
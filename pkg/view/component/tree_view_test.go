@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeView_Marshal(t *testing.T) {
+	pod := NewTreeNode(NewLink("", "nginx-abc", "/pods/nginx-abc"))
+	replicaSet := NewTreeNode(NewText("nginx-123"), pod)
+	replicaSet.Expanded = true
+	deployment := NewTreeNode(NewLink("", "nginx", "/deployments/nginx"), replicaSet)
+
+	tree := NewTreeView()
+	require.NoError(t, tree.AddNode(deployment))
+	require.NoError(t, tree.AddNode(NewTreeNode(NewText("default"))))
+
+	data, err := json.Marshal(tree)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, tree, got)
+
+	nodes := got.(*TreeView).Config.Nodes
+	require.Len(t, nodes, 2)
+	require.Len(t, nodes[0].Children, 1)
+	assert.True(t, nodes[0].Children[0].Expanded)
+	assert.Equal(t, "nginx-abc", nodes[0].Children[0].Children[0].Label.String())
+}
+
+func TestTreeView_AddNode_cycle(t *testing.T) {
+	parent := NewTreeNode(NewText("parent"))
+	child := NewTreeNode(NewText("child"))
+	parent.AddChild(child)
+	child.AddChild(parent)
+
+	tree := NewTreeView()
+	err := tree.AddNode(parent)
+	require.Error(t, err)
+	assert.True(t, tree.IsEmpty())
+}
+
+func TestTreeView_Marshal_cycle(t *testing.T) {
+	parent := NewTreeNode(NewText("parent"))
+
+	tree := NewTreeView()
+	require.NoError(t, tree.AddNode(parent))
+
+	parent.AddChild(parent)
+
+	_, err := json.Marshal(tree)
+	require.Error(t, err)
+}
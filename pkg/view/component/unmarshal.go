@@ -16,11 +16,26 @@ func unmarshal(to TypedObject) (Component, error) {
 	var err error
 
 	switch to.Metadata.Type {
+	case TypeActivityFeed:
+		t := &ActivityFeed{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal activityFeed config")
+		o = t
 	case TypeAnnotations:
 		t := &Annotations{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal annotations config")
 		o = t
+	case TypeBadgeRow:
+		t := &BadgeRow{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal badgeRow config")
+		o = t
+	case TypeBreadcrumb:
+		t := &Breadcrumb{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal breadcrumb config")
+		o = t
 	case TypeButtonGroup:
 		t := &ButtonGroup{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -41,11 +56,31 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal code config")
 		o = t
+	case TypeConditions:
+		t := &Conditions{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal conditions config")
+		o = t
 	case TypeContainers:
 		t := &Containers{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal containers config")
 		o = t
+	case TypeCopyBlock:
+		t := &CopyBlock{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal copyBlock config")
+		o = t
+	case TypeCronSchedule:
+		t := &CronSchedule{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal cronSchedule config")
+		o = t
+	case TypeDescriptionList:
+		t := &DescriptionList{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal descriptionList config")
+		o = t
 	case TypeDonutChart:
 		t := &DonutChart{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -56,16 +91,36 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal editor config")
 		o = t
+	case TypeEmbeddedResponse:
+		t := &EmbeddedResponse{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal embeddedResponse config")
+		o = t
+	case TypeEnvEditor:
+		t := &EnvEditor{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal envEditor config")
+		o = t
 	case TypeError:
 		t := &Error{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal error config")
 		o = t
+	case TypeEventTimeline:
+		t := &EventTimeline{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal eventTimeline config")
+		o = t
 	case TypeExpressionSelector:
 		t := &ExpressionSelector{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal expressionSelector config")
 		o = t
+	case TypeFlameGraph:
+		t := &FlameGraph{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal flameGraph config")
+		o = t
 	case TypeFlexLayout:
 		t := &FlexLayout{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -81,11 +136,31 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal gridActions config")
 		o = t
+	case TypeHistogram:
+		t := &Histogram{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal histogram config")
+		o = t
 	case TypeIFrame:
 		t := &IFrame{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal iframe config")
 		o = t
+	case TypeImage:
+		t := &Image{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal image config")
+		o = t
+	case TypeJSONEditor:
+		t := &JSONEditor{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal jsonEditor config")
+		o = t
+	case TypeJSONPatch:
+		t := &JSONPatch{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal jsonPatch config")
+		o = t
 	case TypeLabels:
 		t := &Labels{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -96,6 +171,21 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal labelSelector config")
 		o = t
+	case TypeLazyTabs:
+		t := &LazyTabs{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal lazyTabs config")
+		o = t
+	case TypeLineChart:
+		t := &LineChart{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal lineChart config")
+		o = t
+	case TypeLiveCounter:
+		t := &LiveCounter{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal liveCounter config")
+		o = t
 	case TypeLoading:
 		t := &Loading{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -116,16 +206,81 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal logs config")
 		o = t
+	case TypeMasked:
+		t := &Masked{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal masked config")
+		o = t
+	case TypeModal:
+		t := &Modal{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal modal config")
+		o = t
+	case TypeNetworkPolicyGraph:
+		t := &NetworkPolicyGraph{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal networkPolicyGraph config")
+		o = t
+	case TypeNotification:
+		t := &Notification{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal notification config")
+		o = t
+	case TypeOperation:
+		t := &Operation{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal operation config")
+		o = t
+	case TypeOwnerChain:
+		t := &OwnerChain{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal ownerChain config")
+		o = t
+	case TypePhaseProgress:
+		t := &PhaseProgress{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal phaseProgress config")
+		o = t
+	case TypeProgressBar:
+		t := &ProgressBar{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal progressBar config")
+		o = t
 	case TypeQuadrant:
 		t := &Quadrant{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal quadrant config")
 		o = t
+	case TypeQuotaUsage:
+		t := &QuotaUsage{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal quotaUsage config")
+		o = t
+	case TypeRegionDistribution:
+		t := &RegionDistribution{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal regionDistribution config")
+		o = t
+	case TypeReleaseNotes:
+		t := &ReleaseNotes{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal releaseNotes config")
+		o = t
+	case TypeResourceRequirements:
+		t := &ResourceRequirements{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal resourceRequirements config")
+		o = t
 	case TypeResourceViewer:
 		t := &ResourceViewer{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal resourceViewer config")
 		o = t
+	case TypeRolloutStatus:
+		t := &RolloutStatus{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal rolloutStatus config")
+		o = t
 	case TypeSelectors:
 		t := &Selectors{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -136,11 +291,21 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal singleStat config")
 		o = t
+	case TypeSparkline:
+		t := &Sparkline{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal sparkline config")
+		o = t
 	case TypeStepper:
 		t := &Stepper{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal stepper config")
 		o = t
+	case TypeStructuredYAML:
+		t := &StructuredYAML{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal structuredYAML config")
+		o = t
 	case TypeSummary:
 		t := &Summary{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -151,19 +316,49 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal table config")
 		o = t
+	case TypeTerminal:
+		t := &Terminal{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal terminal config")
+		o = t
 	case TypeText:
 		t := &Text{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal text config")
 		o = t
+	case TypeTextDiff:
+		t := &TextDiff{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal textDiff config")
+		o = t
+	case TypeTimeRangePicker:
+		t := &TimeRangePicker{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal timeRangePicker config")
+		o = t
 	case TypeTimestamp:
 		t := &Timestamp{Base: Base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal timestamp config")
 		o = t
+	case TypeToggle:
+		t := &Toggle{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal toggle config")
+		o = t
+	case TypeTreeView:
+		t := &TreeView{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal treeView config")
+		o = t
+	case TypeVersionMatrix:
+		t := &VersionMatrix{Base: Base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal versionMatrix config")
+		o = t
 
 	default:
-		return nil, errors.Errorf("unknown view component %q", to.Metadata.Type)
+		return nil, errors.Wrapf(ErrUnknownComponentType, "view component %q", to.Metadata.Type)
 	}
 
 	if err != nil {
@@ -30,7 +30,7 @@ func Test_unmarshal(t *testing.T) {
 			expected: &Annotations{
 				Base: newBase(TypeAnnotations, nil),
 				Config: AnnotationsConfig{
-					map[string]string{
+					Annotations: map[string]string{
 						"foo": "bar",
 					},
 				},
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// VersionMatrixCell is the compatibility of a row with a column.
+type VersionMatrixCell struct {
+	Row    string `json:"row"`
+	Column string `json:"column"`
+	Status Status `json:"status"`
+	Note   string `json:"note,omitempty"`
+}
+
+// VersionMatrixConfig is the contents of VersionMatrix.
+type VersionMatrixConfig struct {
+	// Rows are the names of the rows, e.g. components.
+	Rows []string `json:"rows"`
+	// Columns are the names of the columns, e.g. versions.
+	Columns []string `json:"columns"`
+	// Cells are the cells which have been set, sorted by row and column.
+	Cells []VersionMatrixCell `json:"cells"`
+}
+
+// VersionMatrix is a component showing the compatibility of components with
+// versions. Cells are colored using their status.
+//
+// +octant:component
+type VersionMatrix struct {
+	Base
+	Config VersionMatrixConfig `json:"config"`
+}
+
+var _ Component = (*VersionMatrix)(nil)
+
+// NewVersionMatrix creates a version matrix component.
+func NewVersionMatrix(rows, cols []string) *VersionMatrix {
+	return &VersionMatrix{
+		Base: newBase(TypeVersionMatrix, nil),
+		Config: VersionMatrixConfig{
+			Rows:    rows,
+			Columns: cols,
+			Cells:   []VersionMatrixCell{},
+		},
+	}
+}
+
+// SetCell sets the cell for row and col. Setting a cell again replaces it.
+func (vm *VersionMatrix) SetCell(row, col string, status Status, note string) error {
+	if indexOf(vm.Config.Rows, row) == -1 {
+		return errors.Errorf("row %q not found", row)
+	}
+
+	if indexOf(vm.Config.Columns, col) == -1 {
+		return errors.Wrapf(ErrColumnNotFound, "column %q", col)
+	}
+
+	cell := VersionMatrixCell{Row: row, Column: col, Status: status, Note: note}
+
+	for i := range vm.Config.Cells {
+		if vm.Config.Cells[i].Row == row && vm.Config.Cells[i].Column == col {
+			vm.Config.Cells[i] = cell
+			return nil
+		}
+	}
+
+	vm.Config.Cells = append(vm.Config.Cells, cell)
+	return nil
+}
+
+// IsEmpty returns true if no cells have been set.
+func (vm *VersionMatrix) IsEmpty() bool {
+	return len(vm.Config.Cells) == 0
+}
+
+// indexOf returns the index of s in list, or -1 if it isn't found.
+func indexOf(list []string, s string) int {
+	for i := range list {
+		if list[i] == s {
+			return i
+		}
+	}
+
+	return -1
+}
+
+type versionMatrixMarshal VersionMatrix
+
+// MarshalJSON implements json.Marshaler
+func (vm *VersionMatrix) MarshalJSON() ([]byte, error) {
+	m := versionMatrixMarshal(*vm)
+	m.Metadata.Type = TypeVersionMatrix
+
+	m.Config.Cells = append([]VersionMatrixCell{}, vm.Config.Cells...)
+	sort.SliceStable(m.Config.Cells, func(i, j int) bool {
+		a, b := m.Config.Cells[i], m.Config.Cells[j]
+		if a.Row != b.Row {
+			return indexOf(vm.Config.Rows, a.Row) < indexOf(vm.Config.Rows, b.Row)
+		}
+		return indexOf(vm.Config.Columns, a.Column) < indexOf(vm.Config.Columns, b.Column)
+	})
+
+	return json.Marshal(&m)
+}
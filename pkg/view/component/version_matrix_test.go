@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionMatrix(t *testing.T) {
+	vm := NewVersionMatrix([]string{"octant", "kubectl"}, []string{"1.18", "1.19"})
+	require.True(t, vm.IsEmpty())
+
+	require.NoError(t, vm.SetCell("kubectl", "1.19", StatusOK, ""))
+	require.NoError(t, vm.SetCell("octant", "1.19", StatusWarning, "partial"))
+	require.NoError(t, vm.SetCell("octant", "1.18", StatusError, "unsupported"))
+	require.NoError(t, vm.SetCell("octant", "1.18", StatusOK, ""))
+	require.False(t, vm.IsEmpty())
+
+	data, err := json.Marshal(vm)
+	require.NoError(t, err)
+
+	var to TypedObject
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	got, err := to.ToComponent()
+	require.NoError(t, err)
+
+	AssertEqual(t, vm, got)
+
+	expected := []VersionMatrixCell{
+		{Row: "octant", Column: "1.18", Status: StatusOK},
+		{Row: "octant", Column: "1.19", Status: StatusWarning, Note: "partial"},
+		{Row: "kubectl", Column: "1.19", Status: StatusOK},
+	}
+	assert.Equal(t, expected, got.(*VersionMatrix).Config.Cells)
+}
+
+func TestVersionMatrix_SetCell_unknown(t *testing.T) {
+	vm := NewVersionMatrix([]string{"octant"}, []string{"1.19"})
+
+	require.Error(t, vm.SetCell("kubectl", "1.19", StatusOK, ""))
+
+	err := vm.SetCell("octant", "1.20", StatusOK, "")
+	require.True(t, errors.Is(err, ErrColumnNotFound))
+
+	assert.True(t, vm.IsEmpty())
+}